@@ -3,16 +3,42 @@
 CREATE TABLE IF NOT EXISTS `users` (
   `uid` CHAR(28) NOT NULL COMMENT 'ユーザーID',
   `nickname` VARCHAR(20) NOT NULL COMMENT 'ニックネーム',
-  `email` VARCHAR(255) NOT NULL COMMENT 'メールアドレス',
+  `email` VARCHAR(255) NULL COMMENT 'メールアドレス（匿名ユーザーは NULL）',
+  `disabled` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '無効化フラグ',
+  `is_anonymous` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '匿名ユーザーフラグ',
+  `last_active_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '最終利用日時',
   PRIMARY KEY (`uid`),
-  UNIQUE KEY `uk_users_email` (`email`)
+  UNIQUE KEY `uk_users_email` (`email`),
+  KEY `idx_users_anonymous_active` (`is_anonymous`, `last_active_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
+
+-- Firebase を使わない環境（AUTH_BYPASS の devトークン）向けのロール定義。
+-- Firebase の ID トークンではカスタムクレーム（roles / admin）が使われる。
+CREATE TABLE IF NOT EXISTS `user_roles` (
+  `uid` CHAR(28) NOT NULL COMMENT 'ユーザーID',
+  `role` VARCHAR(20) NOT NULL COMMENT 'ロール',
+  PRIMARY KEY (`uid`, `role`),
+  CONSTRAINT `fk_user_roles_uid` FOREIGN KEY (`uid`) REFERENCES `users` (`uid`)
+    ON DELETE CASCADE ON UPDATE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
 
 CREATE TABLE IF NOT EXISTS `todo_statuses` (
   `status` CHAR(2) NOT NULL COMMENT 'ステータス',
-  PRIMARY KEY (`status`)
+  `label` VARCHAR(20) NOT NULL COMMENT '表示名（API上の値）',
+  `slug` VARCHAR(30) NULL COMMENT '言語に依存しないコード（API上の status_code）',
+  `sort_order` INT NOT NULL DEFAULT 0 COMMENT '表示順（最小のものが新規Todoの既定値）',
+  `is_terminal` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '終了状態か',
+  PRIMARY KEY (`status`),
+  UNIQUE KEY `uk_todo_statuses_label` (`label`),
+  UNIQUE KEY `uk_todo_statuses_slug` (`slug`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
 
+INSERT IGNORE INTO `todo_statuses` (`status`, `label`, `slug`, `sort_order`, `is_terminal`) VALUES
+  ('00', '未着手', 'not_started', 10, 0),
+  ('01', '進行中', 'in_progress', 20, 0),
+  ('02', '完了', 'done', 30, 1),
+  ('03', '保留', 'on_hold', 40, 0);
+
 CREATE TABLE IF NOT EXISTS `todos` (
   `id` CHAR(36) NOT NULL COMMENT 'TodoID',
   `owner` CHAR(28) NOT NULL COMMENT '所有ユーザー',
@@ -42,4 +68,84 @@ CREATE TABLE IF NOT EXISTS `goodlucks` (
     ON DELETE CASCADE ON UPDATE CASCADE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
 
+CREATE TABLE IF NOT EXISTS `personal_access_tokens` (
+  `id` CHAR(36) NOT NULL COMMENT 'トークンID',
+  `owner` CHAR(28) NOT NULL COMMENT '所有ユーザー',
+  `name` VARCHAR(50) NOT NULL COMMENT '名前',
+  `token_hash` CHAR(64) NOT NULL COMMENT 'トークンのSHA-256ハッシュ',
+  `scopes` VARCHAR(255) NOT NULL COMMENT 'スコープ（カンマ区切り）',
+  `expires_at` DATETIME NULL COMMENT '有効期限',
+  `last_used_at` DATETIME NULL COMMENT '最終利用日時',
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_personal_access_tokens_hash` (`token_hash`),
+  KEY `idx_personal_access_tokens_owner` (`owner`),
+  CONSTRAINT `fk_personal_access_tokens_owner` FOREIGN KEY (`owner`) REFERENCES `users` (`uid`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
+
+-- RATE_LIMIT_STORE=mysql の場合に使うレートリミットのカウンタ（複数インスタンスで共有）。
+CREATE TABLE IF NOT EXISTS `rate_limit_counters` (
+  `bucket_key` CHAR(64) NOT NULL COMMENT 'キー（操作・種別・値のSHA-256）',
+  `window_start` BIGINT NOT NULL COMMENT 'ウィンドウ開始（UNIXミリ秒）',
+  `hits` INT NOT NULL DEFAULT 0 COMMENT 'リクエスト数',
+  PRIMARY KEY (`bucket_key`, `window_start`),
+  KEY `idx_rate_limit_counters_window_start` (`window_start`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
+
+
+-- ユーザーごとのステータス遷移ルール。ルールが 1 件も無いユーザーは任意の遷移ができる。
+CREATE TABLE IF NOT EXISTS `todo_status_transitions` (
+  `owner` CHAR(28) NOT NULL COMMENT 'ユーザー',
+  `from_status` CHAR(2) NOT NULL COMMENT '遷移元ステータス',
+  `to_status` CHAR(2) NOT NULL COMMENT '遷移先ステータス',
+  `requires_reason` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '理由の入力が必要か',
+  PRIMARY KEY (`owner`, `from_status`, `to_status`),
+  CONSTRAINT `fk_todo_status_transitions_owner` FOREIGN KEY (`owner`) REFERENCES `users` (`uid`)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT `fk_todo_status_transitions_from` FOREIGN KEY (`from_status`) REFERENCES `todo_statuses` (`status`)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT `fk_todo_status_transitions_to` FOREIGN KEY (`to_status`) REFERENCES `todo_statuses` (`status`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
+
+-- Todo のステータス変更履歴（作成時は from_status が NULL）。
+CREATE TABLE IF NOT EXISTS `todo_status_history` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '履歴ID',
+  `todo` CHAR(36) NOT NULL COMMENT 'Todo',
+  `from_status` CHAR(2) NULL COMMENT '変更前ステータス',
+  `to_status` CHAR(2) NOT NULL COMMENT '変更後ステータス',
+  `reason` VARCHAR(200) NULL COMMENT '理由',
+  `changed_by` CHAR(28) NOT NULL COMMENT '変更したユーザー',
+  `changed_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '変更日時',
+  PRIMARY KEY (`id`),
+  KEY `idx_todo_status_history_todo` (`todo`, `id`),
+  CONSTRAINT `fk_todo_status_history_todo` FOREIGN KEY (`todo`) REFERENCES `todos` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
+
+-- Todo の版（作成・編集のたびに 1 件）。各版は編集後の全項目を持ち、changed_fields に前の版から変わった項目を記録する。
+CREATE TABLE IF NOT EXISTS `todo_revisions` (
+  `todo` CHAR(36) NOT NULL COMMENT 'Todo',
+  `rev` INT NOT NULL COMMENT '版（1 から連番）',
+  `title` VARCHAR(30) NOT NULL COMMENT 'タイトル',
+  `content` TEXT NOT NULL COMMENT '内容',
+  `status` CHAR(2) NOT NULL COMMENT 'ステータス',
+  `due_datetime` DATETIME NULL COMMENT '期限日時',
+  `changed_fields` VARCHAR(100) NOT NULL COMMENT '変更された項目（カンマ区切り）',
+  `changed_by` CHAR(28) NOT NULL COMMENT '変更したユーザー',
+  `changed_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '変更日時',
+  PRIMARY KEY (`todo`, `rev`),
+  CONSTRAINT `fk_todo_revisions_todo` FOREIGN KEY (`todo`) REFERENCES `todos` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
+
+-- 適用済みのスキーマバージョン。スキーマを変更したら app/internal/repo/schema.go の SchemaVersion と合わせて上げ、
+-- 既存 DB 向けの差分を .devcontainer/db/migrations/ に追加する。
+CREATE TABLE IF NOT EXISTS `schema_migrations` (
+  `version` INT NOT NULL COMMENT 'バージョン',
+  `applied_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '適用日時',
+  PRIMARY KEY (`version`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
 
+INSERT IGNORE INTO `schema_migrations` (`version`) VALUES (1), (2), (3), (4), (5), (6);
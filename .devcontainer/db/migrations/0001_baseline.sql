@@ -0,0 +1,51 @@
+-- schema_migrations が無い DB（バージョン管理を始める前の init_table.sql で作った DB）をバージョン 1 にする。
+-- 管理者・無効化、パーソナルアクセストークン、レートリミット、匿名ユーザーのためのカラムとテーブルを追加する。
+ALTER TABLE `users`
+  MODIFY COLUMN `email` VARCHAR(255) NULL COMMENT 'メールアドレス（匿名ユーザーは NULL）',
+  ADD COLUMN `disabled` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '無効化フラグ' AFTER `email`,
+  ADD COLUMN `is_anonymous` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '匿名ユーザーフラグ' AFTER `disabled`,
+  ADD COLUMN `last_active_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '最終利用日時' AFTER `is_anonymous`,
+  ADD KEY `idx_users_anonymous_active` (`is_anonymous`, `last_active_at`);
+
+-- Firebase を使わない環境（AUTH_BYPASS の devトークン）向けのロール定義。
+-- Firebase の ID トークンではカスタムクレーム（roles / admin）が使われる。
+CREATE TABLE IF NOT EXISTS `user_roles` (
+  `uid` CHAR(28) NOT NULL COMMENT 'ユーザーID',
+  `role` VARCHAR(20) NOT NULL COMMENT 'ロール',
+  PRIMARY KEY (`uid`, `role`),
+  CONSTRAINT `fk_user_roles_uid` FOREIGN KEY (`uid`) REFERENCES `users` (`uid`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
+
+CREATE TABLE IF NOT EXISTS `personal_access_tokens` (
+  `id` CHAR(36) NOT NULL COMMENT 'トークンID',
+  `owner` CHAR(28) NOT NULL COMMENT '所有ユーザー',
+  `name` VARCHAR(50) NOT NULL COMMENT '名前',
+  `token_hash` CHAR(64) NOT NULL COMMENT 'トークンのSHA-256ハッシュ',
+  `scopes` VARCHAR(255) NOT NULL COMMENT 'スコープ（カンマ区切り）',
+  `expires_at` DATETIME NULL COMMENT '有効期限',
+  `last_used_at` DATETIME NULL COMMENT '最終利用日時',
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_personal_access_tokens_hash` (`token_hash`),
+  KEY `idx_personal_access_tokens_owner` (`owner`),
+  CONSTRAINT `fk_personal_access_tokens_owner` FOREIGN KEY (`owner`) REFERENCES `users` (`uid`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
+
+-- RATE_LIMIT_STORE=mysql の場合に使うレートリミットのカウンタ（複数インスタンスで共有）。
+CREATE TABLE IF NOT EXISTS `rate_limit_counters` (
+  `bucket_key` CHAR(64) NOT NULL COMMENT 'キー（操作・種別・値のSHA-256）',
+  `window_start` BIGINT NOT NULL COMMENT 'ウィンドウ開始（UNIXミリ秒）',
+  `hits` INT NOT NULL DEFAULT 0 COMMENT 'リクエスト数',
+  PRIMARY KEY (`bucket_key`, `window_start`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
+
+-- 適用済みのスキーマバージョン。
+CREATE TABLE IF NOT EXISTS `schema_migrations` (
+  `version` INT NOT NULL COMMENT 'バージョン',
+  `applied_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '適用日時',
+  PRIMARY KEY (`version`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
+
+INSERT IGNORE INTO `schema_migrations` (`version`) VALUES (1);
@@ -0,0 +1,19 @@
+-- todo_statuses に表示名・表示順・終了フラグを追加し、既定のステータスを投入する。
+ALTER TABLE `todo_statuses`
+  ADD COLUMN `label` VARCHAR(20) NULL COMMENT '表示名（API上の値）' AFTER `status`,
+  ADD COLUMN `sort_order` INT NOT NULL DEFAULT 0 COMMENT '表示順（最小のものが新規Todoの既定値）' AFTER `label`,
+  ADD COLUMN `is_terminal` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '終了状態か' AFTER `sort_order`;
+
+INSERT INTO `todo_statuses` (`status`, `label`, `sort_order`, `is_terminal`) VALUES
+  ('00', '未着手', 10, 0),
+  ('01', '進行中', 20, 0),
+  ('02', '完了', 30, 1),
+  ('03', '保留', 40, 0)
+ON DUPLICATE KEY UPDATE
+  `label` = VALUES(`label`), `sort_order` = VALUES(`sort_order`), `is_terminal` = VALUES(`is_terminal`);
+
+ALTER TABLE `todo_statuses`
+  MODIFY COLUMN `label` VARCHAR(20) NOT NULL COMMENT '表示名（API上の値）',
+  ADD UNIQUE KEY `uk_todo_statuses_label` (`label`);
+
+INSERT IGNORE INTO `schema_migrations` (`version`) VALUES (2);
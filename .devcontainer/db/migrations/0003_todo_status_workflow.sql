@@ -0,0 +1,32 @@
+-- ステータス遷移ルールと変更履歴のテーブルを追加する。
+-- ユーザーごとのステータス遷移ルール。ルールが 1 件も無いユーザーは任意の遷移ができる。
+CREATE TABLE IF NOT EXISTS `todo_status_transitions` (
+  `owner` CHAR(28) NOT NULL COMMENT 'ユーザー',
+  `from_status` CHAR(2) NOT NULL COMMENT '遷移元ステータス',
+  `to_status` CHAR(2) NOT NULL COMMENT '遷移先ステータス',
+  `requires_reason` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '理由の入力が必要か',
+  PRIMARY KEY (`owner`, `from_status`, `to_status`),
+  CONSTRAINT `fk_todo_status_transitions_owner` FOREIGN KEY (`owner`) REFERENCES `users` (`uid`)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT `fk_todo_status_transitions_from` FOREIGN KEY (`from_status`) REFERENCES `todo_statuses` (`status`)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  CONSTRAINT `fk_todo_status_transitions_to` FOREIGN KEY (`to_status`) REFERENCES `todo_statuses` (`status`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
+
+-- Todo のステータス変更履歴（作成時は from_status が NULL）。
+CREATE TABLE IF NOT EXISTS `todo_status_history` (
+  `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '履歴ID',
+  `todo` CHAR(36) NOT NULL COMMENT 'Todo',
+  `from_status` CHAR(2) NULL COMMENT '変更前ステータス',
+  `to_status` CHAR(2) NOT NULL COMMENT '変更後ステータス',
+  `reason` VARCHAR(200) NULL COMMENT '理由',
+  `changed_by` CHAR(28) NOT NULL COMMENT '変更したユーザー',
+  `changed_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '変更日時',
+  PRIMARY KEY (`id`),
+  KEY `idx_todo_status_history_todo` (`todo`, `id`),
+  CONSTRAINT `fk_todo_status_history_todo` FOREIGN KEY (`todo`) REFERENCES `todos` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
+
+INSERT IGNORE INTO `schema_migrations` (`version`) VALUES (3);
@@ -0,0 +1,22 @@
+-- Todo の版管理テーブルを追加する。
+-- Todo の版（作成・編集のたびに 1 件）。各版は編集後の全項目を持ち、changed_fields に前の版から変わった項目を記録する。
+CREATE TABLE IF NOT EXISTS `todo_revisions` (
+  `todo` CHAR(36) NOT NULL COMMENT 'Todo',
+  `rev` INT NOT NULL COMMENT '版（1 から連番）',
+  `title` VARCHAR(30) NOT NULL COMMENT 'タイトル',
+  `content` TEXT NOT NULL COMMENT '内容',
+  `status` CHAR(2) NOT NULL COMMENT 'ステータス',
+  `due_datetime` DATETIME NULL COMMENT '期限日時',
+  `changed_fields` VARCHAR(100) NOT NULL COMMENT '変更された項目（カンマ区切り）',
+  `changed_by` CHAR(28) NOT NULL COMMENT '変更したユーザー',
+  `changed_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '変更日時',
+  PRIMARY KEY (`todo`, `rev`),
+  CONSTRAINT `fk_todo_revisions_todo` FOREIGN KEY (`todo`) REFERENCES `todos` (`id`)
+    ON DELETE CASCADE ON UPDATE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_ja_0900_as_cs;
+
+-- 既存の Todo は現在の内容を版 1 とする（それ以前の履歴は残っていない）。
+INSERT IGNORE INTO `todo_revisions` (`todo`, `rev`, `title`, `content`, `status`, `due_datetime`, `changed_fields`, `changed_by`, `changed_at`)
+SELECT `id`, 1, `title`, `content`, `status`, `due_datetime`, 'title,content,status,due_datetime', `owner`, `updated_at` FROM `todos`;
+
+INSERT IGNORE INTO `schema_migrations` (`version`) VALUES (4);
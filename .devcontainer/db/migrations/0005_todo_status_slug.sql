@@ -0,0 +1,15 @@
+-- todo_statuses に言語に依存しないコード（API上の status_code）を追加する。
+ALTER TABLE `todo_statuses`
+  ADD COLUMN `slug` VARCHAR(30) NULL COMMENT '言語に依存しないコード（API上の status_code）' AFTER `label`,
+  ADD UNIQUE KEY `uk_todo_statuses_slug` (`slug`);
+
+-- 既定のステータスにだけ付ける。管理者が追加したステータスは必要なら手で設定する。
+UPDATE `todo_statuses` SET `slug` = CASE `label`
+    WHEN '未着手' THEN 'not_started'
+    WHEN '進行中' THEN 'in_progress'
+    WHEN '完了' THEN 'done'
+    WHEN '保留' THEN 'on_hold'
+  END
+WHERE `label` IN ('未着手', '進行中', '完了', '保留');
+
+INSERT IGNORE INTO `schema_migrations` (`version`) VALUES (5);
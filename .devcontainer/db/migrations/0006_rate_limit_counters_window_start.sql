@@ -0,0 +1,5 @@
+-- 古いウィンドウの一括削除（どのキーにも当たらなくなった行）のため、rate_limit_counters に window_start のインデックスを追加する。
+ALTER TABLE `rate_limit_counters`
+  ADD KEY `idx_rate_limit_counters_window_start` (`window_start`);
+
+INSERT IGNORE INTO `schema_migrations` (`version`) VALUES (6);
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go-gin-webapi/schemas"
+)
+
+// TokenSource supplies the bearer token of each request.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticToken is a token used as is, e.g. a personal access token (pat_...).
+type StaticToken string
+
+func (t StaticToken) Token(context.Context) (string, error) { return string(t), nil }
+
+// refresher is a TokenSource that can replace a token the server rejected with 401.
+type refresher interface {
+	TokenSource
+	// Refresh returns a token other than stale; a concurrent caller may already have replaced it.
+	Refresh(ctx context.Context, stale string) (string, error)
+}
+
+// ErrLoginRequired is returned when the refresh token is rejected (expired, revoked by /logout, disabled user).
+var ErrLoginRequired = errors.New("client: refresh token rejected, log in again")
+
+// Tokens are the credentials /register, /login and /token return.
+type Tokens struct {
+	UID          string `json:"uid"`
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	// Expiry is when AccessToken expires; zero when unknown.
+	Expiry time.Time `json:"expiry,omitzero"`
+}
+
+// refreshMargin is how long before Expiry the access token is renewed, leaving room for clock skew and latency.
+const refreshMargin = time.Minute
+
+// RefreshingTokenSource hands out an access token and renews it with POST /token shortly before it expires,
+// or when the server rejects it. It is safe for concurrent use; concurrent renewals collapse into one.
+type RefreshingTokenSource struct {
+	api       *ClientWithResponses // without authentication
+	onRefresh func(Tokens)
+
+	mu     sync.Mutex
+	tokens Tokens
+}
+
+// NewRefreshingTokenSource starts from t, e.g. the tokens of a login or a cached copy of them. onRefresh, if not nil,
+// is called with every new pair so it can be saved; Firebase rotates refresh tokens, the old one stops working.
+func NewRefreshingTokenSource(server string, t Tokens, onRefresh func(Tokens)) (*RefreshingTokenSource, error) {
+	api, err := NewClientWithResponses(server, WithHTTPClient(newHTTPClient(nil)))
+	if err != nil {
+		return nil, err
+	}
+	if t.Expiry.IsZero() {
+		t.Expiry = jwtExpiry(t.AccessToken)
+	}
+	return &RefreshingTokenSource{api: api, onRefresh: onRefresh, tokens: t}, nil
+}
+
+// Tokens returns the current tokens.
+func (s *RefreshingTokenSource) Tokens() Tokens {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokens
+}
+
+func (s *RefreshingTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tokens.RefreshToken != "" && !s.tokens.Expiry.IsZero() && time.Until(s.tokens.Expiry) < refreshMargin {
+		if err := s.refresh(ctx); err != nil {
+			return "", err
+		}
+	}
+	return s.tokens.AccessToken, nil
+}
+
+func (s *RefreshingTokenSource) Refresh(ctx context.Context, stale string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tokens.AccessToken != stale {
+		return s.tokens.AccessToken, nil
+	}
+	if s.tokens.RefreshToken == "" {
+		return "", ErrLoginRequired
+	}
+	if err := s.refresh(ctx); err != nil {
+		return "", err
+	}
+	return s.tokens.AccessToken, nil
+}
+
+// refresh must be called with s.mu held.
+func (s *RefreshingTokenSource) refresh(ctx context.Context) error {
+	res, err := s.api.PostTokenWithResponse(ctx, schemas.RefreshTokenRequest{RefreshToken: s.tokens.RefreshToken})
+	if err != nil {
+		return err
+	}
+	if res.StatusCode() == http.StatusUnauthorized {
+		return ErrLoginRequired
+	}
+	if err := Check(res.HTTPResponse, res.Body); err != nil {
+		return err
+	}
+	if res.JSON200 == nil || res.JSON200.AccessToken == nil || res.JSON200.RefreshToken == nil {
+		return errors.New("client: unexpected /token response")
+	}
+	t := Tokens{
+		UID:          s.tokens.UID,
+		AccessToken:  *res.JSON200.AccessToken,
+		RefreshToken: *res.JSON200.RefreshToken,
+	}
+	if res.JSON200.Uid != nil {
+		t.UID = *res.JSON200.Uid
+	}
+	if res.JSON200.ExpiresIn != nil {
+		t.Expiry = time.Now().Add(time.Duration(*res.JSON200.ExpiresIn) * time.Second)
+	} else {
+		t.Expiry = jwtExpiry(t.AccessToken)
+	}
+	s.tokens = t
+	if s.onRefresh != nil {
+		s.onRefresh(t)
+	}
+	return nil
+}
+
+// jwtExpiry reads the exp claim of a JWT without verifying it (the server does); zero for other tokens.
+func jwtExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	b, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(b, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0)
+}
@@ -0,0 +1,57 @@
+// Package client is a typed client of the API. client.gen.go is generated from openapi.yml (make codegen);
+// the models are those of package schemas. New adds bearer authentication and retries on top.
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go-gin-webapi/schemas"
+)
+
+// New returns a client of the API at server (e.g. http://localhost:8080/api/v1). Requests carry the bearer token
+// of tokens (nil: no Authorization header, for /register, /login, ...) and are retried per DefaultRetry.
+// opts are applied after, so WithHTTPClient replaces both.
+func New(server string, tokens TokenSource, opts ...ClientOption) (*ClientWithResponses, error) {
+	return NewClientWithResponses(server, append([]ClientOption{WithHTTPClient(newHTTPClient(tokens))}, opts...)...)
+}
+
+func newHTTPClient(tokens TokenSource) *http.Client {
+	return &http.Client{
+		Timeout:   30 * time.Second,
+		Transport: &Transport{Tokens: tokens, Retry: DefaultRetry},
+	}
+}
+
+// Error is an error response of the API.
+type Error struct {
+	StatusCode int
+	// Problem is the problem+json body; zero when the body is something else. Branch on Problem.Code.
+	Problem schemas.Problem
+}
+
+func (e *Error) Error() string {
+	msg := http.StatusText(e.StatusCode)
+	if e.Problem.Detail != nil {
+		msg = *e.Problem.Detail
+	} else if e.Problem.Title != "" {
+		msg = e.Problem.Title
+	}
+	return fmt.Sprintf("http %d: %s", e.StatusCode, msg)
+}
+
+// Check returns an *Error for a response with status 400 or above, nil otherwise. body is the response's
+// Body field of the generated ...Response types.
+func Check(res *http.Response, body []byte) error {
+	if res.StatusCode < 400 {
+		return nil
+	}
+	e := &Error{StatusCode: res.StatusCode}
+	if strings.HasPrefix(res.Header.Get("Content-Type"), "application/problem+json") {
+		_ = json.Unmarshal(body, &e.Problem)
+	}
+	return e
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy says how often and how long Transport retries.
+type RetryPolicy struct {
+	// MaxAttempts counts the first try; 1 or less disables retries.
+	MaxAttempts int
+	// BaseDelay is the first wait; it doubles per attempt, with jitter.
+	BaseDelay time.Duration
+	// MaxDelay caps a single wait. A Retry-After longer than this is not waited for: the response is returned.
+	MaxDelay time.Duration
+}
+
+var DefaultRetry = RetryPolicy{MaxAttempts: 3, BaseDelay: 200 * time.Millisecond, MaxDelay: 5 * time.Second}
+
+// Transport adds the bearer token of Tokens to each request and retries per Retry:
+//   - 429, honouring Retry-After: the request was rejected before it was handled, so any method is retried;
+//   - 502, 503, 504 and connection errors, for idempotent methods (GET, PUT, DELETE, ...) only.
+//
+// A 401 is retried once with a new token when Tokens can refresh (RefreshingTokenSource).
+// Requests with a body are only retried if it can be rewound (http.Request.GetBody), as the generated client's are.
+type Transport struct {
+	Base   http.RoundTripper // http.DefaultTransport when nil
+	Tokens TokenSource       // nil: no Authorization header
+	Retry  RetryPolicy
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	ctx := req.Context()
+
+	token := ""
+	if t.Tokens != nil && req.Header.Get("Authorization") == "" {
+		var err error
+		if token, err = t.Tokens.Token(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	hasBody := req.Body != nil && req.Body != http.NoBody
+	rewindable := !hasBody || req.GetBody != nil
+	refreshed := false
+	for attempt := 0; ; attempt++ {
+		// The caller's request is not modified; each try sends a copy.
+		r := req.Clone(ctx)
+		if (attempt > 0 || refreshed) && hasBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r.Body = body
+		}
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		res, err := base.RoundTrip(r)
+
+		if err == nil && res.StatusCode == http.StatusUnauthorized && !refreshed && token != "" && rewindable {
+			if rt, ok := t.Tokens.(refresher); ok {
+				drain(res)
+				if token, err = rt.Refresh(ctx, token); err != nil {
+					return nil, err
+				}
+				refreshed = true
+				attempt--
+				continue
+			}
+		}
+
+		if attempt+1 >= t.Retry.MaxAttempts || !rewindable || !retryable(req.Method, res, err) {
+			return res, err
+		}
+		wait := t.backoff(attempt, res)
+		if wait > t.Retry.MaxDelay {
+			return res, err
+		}
+		if res != nil {
+			drain(res)
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func retryable(method string, res *http.Response, err error) bool {
+	if err != nil {
+		return idempotent(method)
+	}
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent(method)
+	}
+	return false
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// backoff is the wait before the retry following attempt: Retry-After when the server sent one,
+// otherwise BaseDelay doubled per attempt with jitter (half to full).
+func (t *Transport) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if secs, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second
+		}
+	}
+	d := t.Retry.BaseDelay << attempt
+	if d <= 0 || d > t.Retry.MaxDelay {
+		d = t.Retry.MaxDelay
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+// drain lets the connection be reused.
+func drain(res *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+	res.Body.Close()
+}
@@ -0,0 +1,280 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fastRetry keeps the tests quick; a Retry-After above MaxDelay is not waited for.
+var fastRetry = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 2 * time.Second}
+
+// reply is one canned response of a test server.
+type reply struct {
+	status     int
+	retryAfter string
+}
+
+// sequence serves replies in order, repeating the last one, and records the bodies it received.
+type sequence struct {
+	mu      sync.Mutex
+	replies []reply
+	bodies  []string
+	auth    []string
+}
+
+func (s *sequence) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b, _ := io.ReadAll(r.Body)
+	s.mu.Lock()
+	s.bodies = append(s.bodies, string(b))
+	s.auth = append(s.auth, r.Header.Get("Authorization"))
+	rep := s.replies[min(len(s.bodies), len(s.replies))-1]
+	s.mu.Unlock()
+	if rep.retryAfter != "" {
+		w.Header().Set("Retry-After", rep.retryAfter)
+	}
+	w.WriteHeader(rep.status)
+}
+
+func TestTransportRetry(t *testing.T) {
+	tests := []struct {
+		name string
+		// method with a body unless it is GET or DELETE; unrewindable drops GetBody.
+		method       string
+		unrewindable bool
+		replies      []reply
+		wantAttempts int
+		wantStatus   int
+	}{
+		{name: "ok", method: http.MethodGet, replies: []reply{{200, ""}}, wantAttempts: 1, wantStatus: 200},
+		{name: "503 then ok", method: http.MethodGet, replies: []reply{{503, ""}, {200, ""}}, wantAttempts: 2, wantStatus: 200},
+		{name: "gives up", method: http.MethodDelete, replies: []reply{{502, ""}}, wantAttempts: 3, wantStatus: 502},
+		{name: "put is idempotent", method: http.MethodPut, replies: []reply{{504, ""}, {200, ""}}, wantAttempts: 2, wantStatus: 200},
+		{name: "post is not retried on 503", method: http.MethodPost, replies: []reply{{503, ""}, {201, ""}}, wantAttempts: 1, wantStatus: 503},
+		{name: "post is retried on 429", method: http.MethodPost, replies: []reply{{429, "0"}, {201, ""}}, wantAttempts: 2, wantStatus: 201},
+		{name: "patch is retried on 429", method: http.MethodPatch, replies: []reply{{429, ""}, {200, ""}}, wantAttempts: 2, wantStatus: 200},
+		{name: "retry-after too long", method: http.MethodGet, replies: []reply{{429, "60"}, {200, ""}}, wantAttempts: 1, wantStatus: 429},
+		{name: "retry-after honoured", method: http.MethodGet, replies: []reply{{429, "1"}, {200, ""}}, wantAttempts: 2, wantStatus: 200},
+		{name: "client error", method: http.MethodGet, replies: []reply{{400, ""}}, wantAttempts: 1, wantStatus: 400},
+		{name: "body not rewindable", method: http.MethodPost, unrewindable: true, replies: []reply{{429, "0"}, {201, ""}}, wantAttempts: 1, wantStatus: 429},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &sequence{replies: tt.replies}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			var body io.Reader
+			if tt.method != http.MethodGet && tt.method != http.MethodDelete {
+				body = strings.NewReader(`{"title":"a"}`)
+			}
+			req, err := http.NewRequest(tt.method, ts.URL, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.unrewindable {
+				req.Body = io.NopCloser(bytes.NewBufferString(`{"title":"a"}`))
+				req.GetBody = nil
+			}
+			c := &http.Client{Transport: &Transport{Tokens: StaticToken("pat_x"), Retry: fastRetry}}
+			res, err := c.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+
+			if res.StatusCode != tt.wantStatus || len(srv.bodies) != tt.wantAttempts {
+				t.Errorf("got %d after %d attempts, want %d after %d", res.StatusCode, len(srv.bodies), tt.wantStatus, tt.wantAttempts)
+			}
+			for i := range srv.bodies {
+				if srv.bodies[i] != srv.bodies[0] {
+					t.Errorf("attempt %d sent %q, the first %q", i+1, srv.bodies[i], srv.bodies[0])
+				}
+				if srv.auth[i] != "Bearer pat_x" {
+					t.Errorf("attempt %d: Authorization = %q", i+1, srv.auth[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTransportRetryStopsWithContext(t *testing.T) {
+	ts := httptest.NewServer(&sequence{replies: []reply{{503, "1"}}})
+	defer ts.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL, nil)
+	c := &http.Client{Transport: &Transport{Retry: fastRetry}}
+	start := time.Now()
+	if _, err := c.Do(req); err == nil {
+		t.Fatal("request succeeded")
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("waited %v for Retry-After after the context was done", time.Since(start))
+	}
+}
+
+// authServer is an API whose /todos accepts only the current access token and whose /token rotates the pair.
+type authServer struct {
+	mu        sync.Mutex
+	access    string
+	refresh   string
+	refreshes int
+	bodies    []string
+}
+
+func (s *authServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.URL.Path {
+	case "/token":
+		b, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(b), `"`+s.refresh+`"`) || s.refresh == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		s.refreshes++
+		s.access = "access" + strconv.Itoa(s.refreshes)
+		s.refresh = "refresh" + strconv.Itoa(s.refreshes)
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"uid":"alice","access_token":"`+s.access+`","refresh_token":"`+s.refresh+`","expires_in":3600}`)
+	default:
+		b, _ := io.ReadAll(r.Body)
+		s.bodies = append(s.bodies, string(b))
+		if r.Header.Get("Authorization") != "Bearer "+s.access {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}
+}
+
+func TestTransportRefresh(t *testing.T) {
+	tests := []struct {
+		name string
+		// tokens the client starts with; the server's current pair is access0/refresh0.
+		tokens Tokens
+		// revoked makes the server reject every refresh token.
+		revoked       bool
+		wantStatus    int
+		wantErr       error
+		wantRefreshes int
+		wantSaved     string // refresh token passed to onRefresh
+	}{
+		{
+			name:       "current token",
+			tokens:     Tokens{AccessToken: "access0", RefreshToken: "refresh0", Expiry: time.Now().Add(time.Hour)},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:          "rejected token is refreshed",
+			tokens:        Tokens{AccessToken: "stale", RefreshToken: "refresh0", Expiry: time.Now().Add(time.Hour)},
+			wantStatus:    http.StatusCreated,
+			wantRefreshes: 1,
+			wantSaved:     "refresh1",
+		},
+		{
+			name:          "expiring token is refreshed first",
+			tokens:        Tokens{AccessToken: "access0", RefreshToken: "refresh0", Expiry: time.Now().Add(10 * time.Second)},
+			wantStatus:    http.StatusCreated,
+			wantRefreshes: 1,
+			wantSaved:     "refresh1",
+		},
+		{
+			name:    "refresh token revoked",
+			tokens:  Tokens{AccessToken: "stale", RefreshToken: "refresh0", Expiry: time.Now().Add(time.Hour)},
+			revoked: true,
+			wantErr: ErrLoginRequired,
+		},
+		{
+			name:    "no refresh token",
+			tokens:  Tokens{AccessToken: "stale"},
+			wantErr: ErrLoginRequired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &authServer{access: "access0", refresh: "refresh0"}
+			if tt.revoked {
+				srv.refresh = ""
+			}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			var saved string
+			src, err := NewRefreshingTokenSource(ts.URL, tt.tokens, func(t Tokens) { saved = t.RefreshToken })
+			if err != nil {
+				t.Fatal(err)
+			}
+			req, _ := http.NewRequest(http.MethodPost, ts.URL+"/todos", strings.NewReader(`{"title":"a"}`))
+			c := &http.Client{Transport: &Transport{Tokens: src, Retry: fastRetry}}
+			res, err := c.Do(req)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+			if res.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", res.StatusCode, tt.wantStatus)
+			}
+			if srv.refreshes != tt.wantRefreshes || saved != tt.wantSaved {
+				t.Errorf("refreshed %d times, saved %q; want %d, %q", srv.refreshes, saved, tt.wantRefreshes, tt.wantSaved)
+			}
+			for i, b := range srv.bodies {
+				if b != `{"title":"a"}` {
+					t.Errorf("attempt %d sent %q", i+1, b)
+				}
+			}
+		})
+	}
+}
+
+func TestTransportStaticTokenNotRefreshed(t *testing.T) {
+	srv := &authServer{access: "access0", refresh: "refresh0"}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/todos", nil)
+	c := &http.Client{Transport: &Transport{Tokens: StaticToken("pat_x"), Retry: fastRetry}}
+	res, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusUnauthorized || len(srv.bodies) != 1 || srv.refreshes != 0 {
+		t.Errorf("got %d after %d attempts and %d refreshes, want one 401", res.StatusCode, len(srv.bodies), srv.refreshes)
+	}
+}
+
+func TestJWTExpiry(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  time.Time
+	}{
+		// {"exp":1700000000}
+		{name: "jwt", token: "e30.eyJleHAiOjE3MDAwMDAwMDB9.sig", want: time.Unix(1700000000, 0)},
+		{name: "no exp", token: "e30.e30.sig"},
+		{name: "access token", token: "pat_abc"},
+		{name: "bad payload", token: "a.!!.c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jwtExpiry(tt.token); !got.Equal(tt.want) {
+				t.Errorf("jwtExpiry(%q) = %v, want %v", tt.token, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,453 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"go-gin-webapi/client"
+	"go-gin-webapi/schemas"
+)
+
+// env is what commands share: the global flags and the terminal.
+type env struct {
+	*globals
+	stdin  io.Reader
+	stdout io.Writer
+}
+
+// session is a client logged in as one profile.
+type session struct {
+	api     *client.ClientWithResponses
+	profile *profile
+}
+
+// login opens the session of the selected profile. Refreshed tokens are written back to the cache.
+func (e *env) login() (*session, error) {
+	ps, err := loadProfiles()
+	if err != nil {
+		return nil, err
+	}
+	p, err := ps.find(ps.server(e.server), e.user)
+	if err != nil {
+		return nil, err
+	}
+	server, user := p.Server, p.User
+	ts, err := client.NewRefreshingTokenSource(server, p.Tokens, func(t client.Tokens) {
+		// Reread: another todoctl may have changed the file since.
+		ps, err := loadProfiles()
+		if err != nil {
+			return
+		}
+		q, err := ps.find(server, user)
+		if err != nil {
+			return
+		}
+		q.Tokens = t
+		if err := ps.save(); err != nil {
+			fmt.Fprintf(os.Stderr, "todoctl: saving the refreshed tokens: %v\n", err)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	api, err := client.New(server, ts, client.WithRequestEditorFn(acceptLanguage))
+	if err != nil {
+		return nil, err
+	}
+	return &session{api: api, profile: p}, nil
+}
+
+// acceptLanguage asks for error messages in the language of the locale (LANG=ja_JP.UTF-8 gives Japanese).
+func acceptLanguage(_ context.Context, req *http.Request) error {
+	for _, v := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if l := os.Getenv(v); l != "" {
+			l, _, _ = strings.Cut(l, ".")
+			if l != "C" && l != "POSIX" {
+				req.Header.Set("Accept-Language", strings.ReplaceAll(l, "_", "-"))
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+func runLogin(ctx context.Context, e *env, args []string) error {
+	var fs flag.FlagSet
+	passwordStdin := fs.Bool("password-stdin", false, "read the password from stdin")
+	if _, err := e.parse("login", &fs, args, 0, 0); err != nil {
+		return err
+	}
+	ps, err := loadProfiles()
+	if err != nil {
+		return err
+	}
+	server := ps.server(e.server)
+
+	in := bufio.NewReader(e.stdin)
+	email := e.user
+	if email == "" {
+		fmt.Fprint(os.Stderr, "Email: ")
+		if email, err = readLine(in); err != nil {
+			return err
+		}
+	}
+	var password string
+	if *passwordStdin {
+		password, err = readLine(in)
+	} else {
+		password, err = readPassword(in)
+	}
+	if err != nil {
+		return err
+	}
+
+	api, err := client.New(server, nil, client.WithRequestEditorFn(acceptLanguage))
+	if err != nil {
+		return err
+	}
+	res, err := api.PostLoginWithResponse(ctx, schemas.LoginUserRequest{Email: openapi_types.Email(email), Password: password})
+	if err != nil {
+		return err
+	}
+	if err := client.Check(res.HTTPResponse, res.Body); err != nil {
+		return err
+	}
+	if res.JSON201 == nil || res.JSON201.Uid == nil || res.JSON201.AccessToken == nil || res.JSON201.RefreshToken == nil {
+		return errors.New("unexpected /login response")
+	}
+	p := &profile{Server: server, User: email, Tokens: client.Tokens{
+		UID:          *res.JSON201.Uid,
+		AccessToken:  *res.JSON201.AccessToken,
+		RefreshToken: *res.JSON201.RefreshToken,
+	}}
+	ps.put(p)
+	if err := ps.save(); err != nil {
+		return err
+	}
+	fmt.Fprintf(e.stdout, "logged in to %s as %s\n", server, email)
+	return nil
+}
+
+func runLogout(ctx context.Context, e *env, args []string) error {
+	if _, err := e.parse("logout", &flag.FlagSet{}, args, 0, 0); err != nil {
+		return err
+	}
+	s, err := e.login()
+	if err != nil {
+		return err
+	}
+	res, err := s.api.PostLogoutWithResponse(ctx, schemas.LogoutUserRequest{UserId: s.profile.UID})
+	// Forget the tokens even if the server could not be reached: the user asked to be logged out here.
+	ps, lerr := loadProfiles()
+	if lerr != nil {
+		return lerr
+	}
+	if p, ferr := ps.find(s.profile.Server, s.profile.User); ferr == nil {
+		ps.remove(p)
+		if err := ps.save(); err != nil {
+			return err
+		}
+	}
+	if err != nil && !errors.Is(err, client.ErrLoginRequired) {
+		return err
+	}
+	if err == nil {
+		if err := client.Check(res.HTTPResponse, res.Body); err != nil && res.StatusCode() != http.StatusUnauthorized {
+			return err
+		}
+	}
+	fmt.Fprintf(e.stdout, "logged out of %s as %s\n", s.profile.Server, s.profile.User)
+	return nil
+}
+
+func runList(ctx context.Context, e *env, args []string) error {
+	if _, err := e.parse("list", &flag.FlagSet{}, args, 0, 0); err != nil {
+		return err
+	}
+	s, err := e.login()
+	if err != nil {
+		return err
+	}
+	res, err := s.api.GetUsersUserIdTodosWithResponse(ctx, s.profile.UID)
+	if err != nil {
+		return err
+	}
+	if err := client.Check(res.HTTPResponse, res.Body); err != nil {
+		return err
+	}
+	if res.JSON200 == nil {
+		return errors.New("unexpected response")
+	}
+	todos := *res.JSON200
+	if e.output != "table" {
+		return e.print(todos)
+	}
+	rows := make([]todoRow, 0, len(todos))
+	for _, t := range todos {
+		rows = append(rows, todoRow{id: deref(t.Id), status: statusOf(t.StatusCode, t.Status), due: deref(t.DueDatetime), title: deref(t.Title)})
+	}
+	return e.printTodos(rows)
+}
+
+func runAdd(ctx context.Context, e *env, args []string) error {
+	var fs flag.FlagSet
+	content := fs.String("content", "", "details")
+	due := fs.String("due", "", `due date, "yyyy/mm/dd hh:mm"`)
+	status := fs.String("status", "", "status code (see GET /todo-statuses), e.g. in_progress")
+	pos, err := e.parse("add", &fs, args, 1, -1)
+	if err != nil {
+		return err
+	}
+	s, err := e.login()
+	if err != nil {
+		return err
+	}
+	req := schemas.CreateTodoRequest{Title: strings.Join(pos, " "), Content: *content}
+	if *due != "" {
+		req.DueDatetime = due
+	}
+	if *status != "" {
+		req.StatusCode = status
+	}
+	res, err := s.api.PostUsersUserIdTodosWithResponse(ctx, s.profile.UID, req)
+	if err != nil {
+		return err
+	}
+	if err := client.Check(res.HTTPResponse, res.Body); err != nil {
+		return err
+	}
+	if res.JSON201 == nil {
+		return errors.New("unexpected response")
+	}
+	if e.output != "table" {
+		return e.print(res.JSON201)
+	}
+	fmt.Fprintf(e.stdout, "created %s\n", deref(res.JSON201.Id))
+	return nil
+}
+
+func runEdit(ctx context.Context, e *env, args []string) error {
+	var fs flag.FlagSet
+	fs.String("title", "", "new title")
+	fs.String("content", "", "new details")
+	fs.String("due", "", `new due date, "yyyy/mm/dd hh:mm"; "" removes it`)
+	fs.String("status", "", "new status code")
+	fs.String("reason", "", "reason for the status change")
+	pos, err := e.parse("edit", &fs, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	// Only the flags given go into the merge patch.
+	patch := map[string]any{}
+	fields := map[string]string{"title": "title", "content": "content", "due": "due_datetime", "status": "status_code", "reason": "status_reason"}
+	fs.Visit(func(f *flag.Flag) {
+		field, ok := fields[f.Name]
+		if !ok {
+			return
+		}
+		if v := f.Value.String(); v != "" || f.Name != "due" {
+			patch[field] = v
+		} else {
+			patch[field] = nil
+		}
+	})
+	if len(patch) == 0 {
+		return usageError{errors.New("edit: nothing to change")}
+	}
+	s, err := e.login()
+	if err != nil {
+		return err
+	}
+	t, err := patchTodo(ctx, s, pos[0], patch)
+	if err != nil {
+		return err
+	}
+	if e.output != "table" {
+		return e.print(t)
+	}
+	return e.printTodos([]todoRow{detailRow(pos[0], t)})
+}
+
+func runDone(ctx context.Context, e *env, args []string) error {
+	var fs flag.FlagSet
+	reason := fs.String("reason", "", "reason for the status change")
+	pos, err := e.parse("done", &fs, args, 1, -1)
+	if err != nil {
+		return err
+	}
+	s, err := e.login()
+	if err != nil {
+		return err
+	}
+	patch := map[string]any{"status_code": "done"}
+	if *reason != "" {
+		patch["status_reason"] = *reason
+	}
+	todos := make([]*schemas.GetTodoDetailResponse, 0, len(pos))
+	rows := make([]todoRow, 0, len(pos))
+	for _, id := range pos {
+		t, err := patchTodo(ctx, s, id, patch)
+		if err != nil {
+			return fmt.Errorf("%s: %w", id, err)
+		}
+		todos = append(todos, t)
+		rows = append(rows, detailRow(id, t))
+	}
+	if e.output != "table" {
+		return e.print(todos)
+	}
+	return e.printTodos(rows)
+}
+
+// patchTodo applies a JSON Merge Patch to the todo and returns the result.
+func patchTodo(ctx context.Context, s *session, id string, patch map[string]any) (*schemas.GetTodoDetailResponse, error) {
+	b, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.api.PatchUsersUserIdTodosTodoIdWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, s.profile.UID, id, schemas.TodoMergePatch(b))
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Check(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, errors.New("unexpected response")
+	}
+	return res.JSON200, nil
+}
+
+func runRm(ctx context.Context, e *env, args []string) error {
+	pos, err := e.parse("rm", &flag.FlagSet{}, args, 1, -1)
+	if err != nil {
+		return err
+	}
+	s, err := e.login()
+	if err != nil {
+		return err
+	}
+	for _, id := range pos {
+		res, err := s.api.DeleteUsersUserIdTodosTodoIdWithResponse(ctx, s.profile.UID, id)
+		if err != nil {
+			return err
+		}
+		if err := client.Check(res.HTTPResponse, res.Body); err != nil {
+			return fmt.Errorf("%s: %w", id, err)
+		}
+		if e.output == "table" {
+			fmt.Fprintf(e.stdout, "deleted %s\n", id)
+		}
+	}
+	return nil
+}
+
+func runCheer(ctx context.Context, e *env, args []string) error {
+	pos, err := e.parse("cheer", &flag.FlagSet{}, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	s, err := e.login()
+	if err != nil {
+		return err
+	}
+	res, err := s.api.PostUsersUserIdTodosTodoIdGoodlucksWithResponse(ctx, s.profile.UID, pos[0], schemas.CreateGoodluckRequest{})
+	if err != nil {
+		return err
+	}
+	if err := client.Check(res.HTTPResponse, res.Body); err != nil {
+		return err
+	}
+	if e.output != "table" {
+		return e.print(res.JSON201)
+	}
+	fmt.Fprintf(e.stdout, "cheered %s\n", pos[0])
+	return nil
+}
+
+func runProfiles(_ context.Context, e *env, args []string) error {
+	if _, err := e.parse("profiles", &flag.FlagSet{}, args, 0, 0); err != nil {
+		return err
+	}
+	ps, err := loadProfiles()
+	if err != nil {
+		return err
+	}
+	type row struct {
+		Server  string `json:"server"`
+		User    string `json:"user"`
+		UID     string `json:"uid"`
+		Default bool   `json:"default"`
+	}
+	rows := make([]row, 0, len(ps.Profiles))
+	for i, p := range ps.Profiles {
+		rows = append(rows, row{Server: p.Server, User: p.User, UID: p.UID, Default: i == ps.Default})
+	}
+	if e.output != "table" {
+		return e.print(rows)
+	}
+	tw := newTable(e.stdout, "", "SERVER", "USER", "UID")
+	for _, r := range rows {
+		mark := ""
+		if r.Default {
+			mark = "*"
+		}
+		tw.row(mark, r.Server, r.User, r.UID)
+	}
+	return tw.flush()
+}
+
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// readPassword prompts for the password with echo turned off. stty keeps todoctl free of terminal packages;
+// where it is missing, --password-stdin is the way.
+func readPassword(r *bufio.Reader) (string, error) {
+	fmt.Fprint(os.Stderr, "Password: ")
+	stty := func(arg string) error {
+		cmd := exec.Command("stty", arg)
+		cmd.Stdin = os.Stdin
+		return cmd.Run()
+	}
+	if err := stty("-echo"); err != nil {
+		fmt.Fprintln(os.Stderr)
+		return "", errors.New("cannot turn off echo on this terminal; use --password-stdin")
+	}
+	defer func() {
+		_ = stty("echo")
+		fmt.Fprintln(os.Stderr)
+	}()
+	return readLine(r)
+}
+
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
+
+// statusOf prefers the language-independent code; the label is for statuses created without one.
+func statusOf(code, label *string) string {
+	if c := deref(code); c != "" {
+		return c
+	}
+	return deref(label)
+}
@@ -0,0 +1,159 @@
+// Command todoctl manages your todos from the terminal through the API.
+//
+//	todoctl [--server url] [--user email] [-o table|json|yaml] <command> [args]
+//
+// login caches the tokens under ~/.config/todoctl; every other command uses and refreshes them.
+// Each server and user pair is a profile, and the last login is the default one.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"go-gin-webapi/client"
+)
+
+const usage = `usage: todoctl [--server url] [--user email] [-o table|json|yaml] <command> [args]
+
+commands:
+  login [--password-stdin]  log in and cache the tokens
+  logout                    revoke the session and forget the tokens
+  list                      list your todos
+  add [flags] <title>       add a todo: --content text, --due "yyyy/mm/dd hh:mm", --status code
+  edit [flags] <id>         change a todo: --title, --content, --due ("" removes it), --status, --reason
+  done [--reason r] <id>... mark todos done
+  rm <id>...                delete todos
+  cheer <id>                send a goodluck to a todo
+  profiles                  list the cached profiles; * is the default
+
+The server defaults to $TODOCTL_SERVER, then the default profile's, then http://localhost:8080/api/v1.
+Global flags can also follow the command.
+`
+
+// globals are the flags every command accepts.
+type globals struct {
+	server string
+	user   string
+	output string
+}
+
+func (g *globals) register(fs *flag.FlagSet) {
+	fs.StringVar(&g.server, "server", g.server, "API base URL")
+	fs.StringVar(&g.user, "user", g.user, "email of the profile to use")
+	fs.StringVar(&g.output, "o", g.output, "output format: table, json or yaml")
+	fs.StringVar(&g.output, "output", g.output, "output format: table, json or yaml")
+}
+
+type command func(ctx context.Context, e *env, args []string) error
+
+var commands = map[string]command{
+	"login":    runLogin,
+	"logout":   runLogout,
+	"list":     runList,
+	"add":      runAdd,
+	"edit":     runEdit,
+	"done":     runDone,
+	"rm":       runRm,
+	"cheer":    runCheer,
+	"profiles": runProfiles,
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		stop()
+		var ue usageError
+		if errors.As(err, &ue) {
+			fmt.Fprintf(os.Stderr, "todoctl: %v\n\n%s", err, usage)
+			os.Exit(2)
+		}
+		fmt.Fprintf(os.Stderr, "todoctl: %v\n", explain(err))
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdin io.Reader, stdout io.Writer) error {
+	g := globals{output: "table"}
+	fs := flag.NewFlagSet("todoctl", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	g.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return usageError{err}
+	}
+	if fs.NArg() == 0 {
+		return usageError{errors.New("missing command")}
+	}
+	name := fs.Arg(0)
+	cmd, ok := commands[name]
+	if !ok {
+		return usageError{fmt.Errorf("unknown command %q", name)}
+	}
+	e := &env{globals: &g, stdin: stdin, stdout: stdout}
+	return cmd(ctx, e, fs.Args()[1:])
+}
+
+// usageError is a mistake on the command line; main prints the usage with it.
+type usageError struct{ err error }
+
+func (e usageError) Error() string { return e.err.Error() }
+
+// parse parses a command's flags, the global ones included, and checks the number of positional arguments.
+func (e *env) parse(name string, fs *flag.FlagSet, args []string, minArgs, maxArgs int) ([]string, error) {
+	fs.Init(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	e.globals.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return nil, usageError{fmt.Errorf("%s: %w", name, err)}
+	}
+	// Flags after the first positional argument, e.g. `todoctl rm abc -o json`.
+	rest := fs.Args()
+	var pos []string
+	for len(rest) > 0 {
+		pos = append(pos, rest[0])
+		if err := fs.Parse(rest[1:]); err != nil {
+			return nil, usageError{fmt.Errorf("%s: %w", name, err)}
+		}
+		rest = fs.Args()
+	}
+	if len(pos) < minArgs || (maxArgs >= 0 && len(pos) > maxArgs) {
+		return nil, usageError{fmt.Errorf("%s: wrong number of arguments", name)}
+	}
+	switch e.output {
+	case "table", "json", "yaml":
+	default:
+		return nil, usageError{fmt.Errorf("unknown output format %q", e.output)}
+	}
+	return pos, nil
+}
+
+// explain turns API errors into something to act on.
+func explain(err error) error {
+	if errors.Is(err, client.ErrLoginRequired) {
+		return errors.New("the session has expired; run todoctl login")
+	}
+	var ae *client.Error
+	if !errors.As(err, &ae) {
+		return err
+	}
+	msg := ae.Error()
+	if ae.Problem.Errors != nil {
+		var b strings.Builder
+		b.WriteString(msg)
+		for _, fe := range *ae.Problem.Errors {
+			fmt.Fprintf(&b, "\n  %s: %s", fe.Field, fe.Message)
+		}
+		msg = b.String()
+	}
+	if ae.StatusCode == 401 {
+		msg += "\n(run todoctl login)"
+	}
+	return errors.New(msg)
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+
+	"go-gin-webapi/schemas"
+)
+
+// print writes v as JSON or YAML. The schemas types only have json tags, so YAML is converted from the JSON
+// to keep the API's field names and order.
+func (e *env) print(v any) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if e.output == "json" {
+		_, err := fmt.Fprintf(e.stdout, "%s\n", b)
+		return err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return err
+	}
+	blockStyle(&doc)
+	enc := yaml.NewEncoder(e.stdout)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// blockStyle undoes the JSON syntax the document was parsed with: flow collections ({...}, [...]) and quoted
+// keys and strings. The encoder quotes again what needs it.
+func blockStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		blockStyle(c)
+	}
+}
+
+type todoRow struct {
+	id, status, due, title string
+}
+
+// detailRow is the row of a todo response, which has no id.
+func detailRow(id string, t *schemas.GetTodoDetailResponse) todoRow {
+	return todoRow{id: id, status: statusOf(t.StatusCode, t.Status), due: deref(t.DueDatetime), title: deref(t.Title)}
+}
+
+func (e *env) printTodos(rows []todoRow) error {
+	t := newTable(e.stdout, "ID", "STATUS", "DUE", "TITLE")
+	for _, r := range rows {
+		t.row(r.id, r.status, r.due, r.title)
+	}
+	return t.flush()
+}
+
+type table struct {
+	w *tabwriter.Writer
+}
+
+func newTable(w io.Writer, header ...string) *table {
+	t := &table{w: tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)}
+	t.row(header...)
+	return t
+}
+
+func (t *table) row(cells ...string) {
+	for i, c := range cells {
+		// A tab or newline in a title would break the columns.
+		cells[i] = strings.Join(strings.Fields(c), " ")
+	}
+	fmt.Fprintln(t.w, strings.Join(cells, "\t"))
+}
+
+func (t *table) flush() error { return t.w.Flush() }
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"go-gin-webapi/client"
+)
+
+const defaultServer = "http://localhost:8080/api/v1"
+
+// profile is one server and user pair with its cached tokens.
+type profile struct {
+	Server string `json:"server"`
+	User   string `json:"user"`
+	client.Tokens
+}
+
+// profiles is the token cache, ~/.config/todoctl/profiles.json. It holds refresh tokens, so it is only readable
+// by its owner.
+type profiles struct {
+	// Default is the index of the profile used without --server and --user: the last login.
+	Default  int        `json:"default"`
+	Profiles []*profile `json:"profiles"`
+}
+
+func profilesPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "todoctl", "profiles.json"), nil
+}
+
+func loadProfiles() (*profiles, error) {
+	path, err := profilesPath()
+	if err != nil {
+		return nil, err
+	}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &profiles{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var ps profiles
+	if err := json.Unmarshal(b, &ps); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &ps, nil
+}
+
+// save replaces the file atomically, so a refresh racing another todoctl never leaves it half written.
+func (ps *profiles) save() error {
+	path, err := profilesPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(ps, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), ".profiles-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(append(b, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func (ps *profiles) defaultProfile() *profile {
+	if ps.Default < 0 || ps.Default >= len(ps.Profiles) {
+		return nil
+	}
+	return ps.Profiles[ps.Default]
+}
+
+// server resolves the server: --server, $TODOCTL_SERVER, the default profile's, then localhost.
+func (ps *profiles) server(flagServer string) string {
+	if flagServer != "" {
+		return flagServer
+	}
+	if s := os.Getenv("TODOCTL_SERVER"); s != "" {
+		return s
+	}
+	if p := ps.defaultProfile(); p != nil {
+		return p.Server
+	}
+	return defaultServer
+}
+
+// find returns the profile of user on server; without a user, the default profile if it is on server,
+// otherwise the only profile on server.
+func (ps *profiles) find(server, user string) (*profile, error) {
+	if user == "" {
+		if p := ps.defaultProfile(); p != nil && p.Server == server {
+			return p, nil
+		}
+		var found *profile
+		for _, p := range ps.Profiles {
+			if p.Server != server {
+				continue
+			}
+			if found != nil {
+				return nil, fmt.Errorf("several profiles on %s; pick one with --user", server)
+			}
+			found = p
+		}
+		if found == nil {
+			return nil, fmt.Errorf("not logged in to %s; run todoctl login", server)
+		}
+		return found, nil
+	}
+	for _, p := range ps.Profiles {
+		if p.Server == server && p.User == user {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("not logged in to %s as %s; run todoctl login", server, user)
+}
+
+// put adds or replaces the profile of p.Server and p.User and makes it the default.
+func (ps *profiles) put(p *profile) {
+	for i, q := range ps.Profiles {
+		if q.Server == p.Server && q.User == p.User {
+			ps.Profiles[i] = p
+			ps.Default = i
+			return
+		}
+	}
+	ps.Profiles = append(ps.Profiles, p)
+	ps.Default = len(ps.Profiles) - 1
+}
+
+func (ps *profiles) remove(p *profile) {
+	for i, q := range ps.Profiles {
+		if q != p {
+			continue
+		}
+		ps.Profiles = append(ps.Profiles[:i], ps.Profiles[i+1:]...)
+		switch {
+		case ps.Default == i:
+			ps.Default = 0
+		case ps.Default > i:
+			ps.Default--
+		}
+		return
+	}
+}
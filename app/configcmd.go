@@ -0,0 +1,28 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"go-gin-webapi/internal/config"
+)
+
+// runConfig implements `go run . [-config file] config print`.
+// It prints the effective config (defaults, file, then env) with secrets redacted, then fails with loadErr if any.
+func runConfig(cfg config.Config, loadErr error, args []string) error {
+	if len(args) != 1 || args[0] != "print" {
+		return errors.New("usage: config print")
+	}
+	out, err := yaml.Marshal(cfg.Redacted())
+	if err != nil {
+		return err
+	}
+	os.Stdout.Write(out)
+	if loadErr != nil {
+		return fmt.Errorf("config: %w", loadErr)
+	}
+	return nil
+}
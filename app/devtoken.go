@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"go-gin-webapi/internal/auth"
+	"go-gin-webapi/internal/config"
+)
+
+// runDevToken implements `go run . devtoken [-ttl 24h] <uid>`.
+// It prints a signed token that the server accepts as `Authorization: Bearer <token>` when AUTH_BYPASS is on.
+func runDevToken(cfg config.Config, args []string) error {
+	fs := flag.NewFlagSet("devtoken", flag.ContinueOnError)
+	ttl := fs.Duration("ttl", 24*time.Hour, "token lifetime")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: devtoken [-ttl 24h] <uid>")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("devtoken: exactly one uid is required")
+	}
+	if !cfg.IsDevelopment() {
+		return fmt.Errorf("devtoken: APP_ENV must be development (got %q)", cfg.Env)
+	}
+	token, err := auth.MintDevToken([]byte(cfg.Auth.DevTokenSecret), fs.Arg(0), *ttl)
+	if err != nil {
+		return fmt.Errorf("devtoken: %w", err)
+	}
+	fmt.Fprintln(os.Stdout, token)
+	return nil
+}
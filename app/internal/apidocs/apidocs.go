@@ -0,0 +1,113 @@
+// Package apidocs serves the OpenAPI document as JSON and YAML and a Swagger UI page for it.
+package apidocs
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v3"
+)
+
+// ui is the Swagger UI page: index.html and, unmodified, swagger-ui-bundle.js and swagger-ui.css of
+// swagger-ui 5.17.14 with its LICENSE (Apache-2.0). The build fetches nothing and the page works without a CDN.
+//
+//go:embed ui
+var ui embed.FS
+
+// Docs serves one spec. Its servers are replaced per request with the instance the request reached,
+// so "Try it out" calls this server rather than the one written in openapi.yml.
+type Docs struct {
+	baseURL string
+	json    map[string]any
+	yaml    *yaml.Node // mapping node of the document
+}
+
+// New prepares spec for serving; source is the YAML it was loaded from (kept as written, comments included),
+// baseURL the prefix the API is registered under.
+func New(spec *openapi3.T, source []byte, baseURL string) (*Docs, error) {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	d := &Docs{baseURL: baseURL}
+	if err := json.Unmarshal(b, &d.json); err != nil {
+		return nil, err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(source, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("openapi document is not a mapping")
+	}
+	d.yaml = doc.Content[0]
+	return d, nil
+}
+
+// JSON serves the spec as JSON (/swagger.json).
+func (d *Docs) JSON(c *gin.Context) {
+	out := make(map[string]any, len(d.json)+1)
+	for k, v := range d.json {
+		out[k] = v
+	}
+	out["servers"] = []map[string]string{{"url": d.serverURL(c)}}
+	c.JSON(http.StatusOK, out)
+}
+
+// YAML serves the spec as YAML (/openapi.yaml).
+func (d *Docs) YAML(c *gin.Context) {
+	servers := &yaml.Node{Kind: yaml.SequenceNode, Content: []*yaml.Node{{
+		Kind: yaml.MappingNode,
+		Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Value: "url"},
+			{Kind: yaml.ScalarNode, Value: d.serverURL(c)},
+		},
+	}}}
+	// A shallow copy: only the servers entry differs from the shared document.
+	root := *d.yaml
+	root.Content = make([]*yaml.Node, 0, len(d.yaml.Content)+2)
+	replaced := false
+	for i := 0; i+1 < len(d.yaml.Content); i += 2 {
+		key, value := d.yaml.Content[i], d.yaml.Content[i+1]
+		if key.Value == "servers" {
+			value, replaced = servers, true
+		}
+		root.Content = append(root.Content, key, value)
+	}
+	if !replaced {
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: "servers"}, servers)
+	}
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2) // as openapi.yml
+	if err := enc.Encode(&root); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.Data(http.StatusOK, "application/yaml; charset=utf-8", out.Bytes())
+}
+
+// UI returns the file system of the Swagger UI page, to be served under /docs/.
+func UI() http.FileSystem {
+	sub, err := fs.Sub(ui, "ui")
+	if err != nil {
+		panic(err) // ui is embedded above
+	}
+	return http.FS(sub)
+}
+
+// serverURL is the API's base URL as seen by the client. X-Forwarded-Proto is taken as is: it only decides
+// where the docs page sends its requests.
+func (d *Docs) serverURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + c.Request.Host + d.baseURL
+}
@@ -0,0 +1,42 @@
+package apidocs
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestUI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.StaticFS("/docs", UI())
+
+	tests := []struct {
+		path        string
+		wantType    string
+		wantContent string
+	}{
+		{path: "/docs/", wantType: "text/html", wantContent: "SwaggerUIBundle({"},
+		{path: "/docs/swagger-ui-bundle.js", wantType: "javascript", wantContent: `PACKAGE_VERSION:"5.17.14"`},
+		{path: "/docs/swagger-ui.css", wantType: "text/css", wantContent: ".swagger-ui"},
+		{path: "/docs/LICENSE", wantContent: "Apache License"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("GET %s = %d, want 200", tt.path, w.Code)
+			}
+			if ct := w.Header().Get("Content-Type"); !strings.Contains(ct, tt.wantType) {
+				t.Errorf("Content-Type = %q, want %s", ct, tt.wantType)
+			}
+			if !strings.Contains(w.Body.String(), tt.wantContent) {
+				t.Errorf("GET %s does not contain %q", tt.path, tt.wantContent)
+			}
+		})
+	}
+}
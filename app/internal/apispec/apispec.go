@@ -0,0 +1,107 @@
+package apispec
+
+import (
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gin-gonic/gin"
+)
+
+// Operation is an OpenAPI operation resolved to the gin route it is registered under.
+type Operation struct {
+	Method string
+	// Path is the OpenAPI path template, e.g. /users/{user_id}.
+	Path string
+	// Route is the gin route template, e.g. /api/v1/users/:user_id.
+	Route string
+	// Security is the effective security requirement (operation level, falling back to the document level).
+	Security openapi3.SecurityRequirements
+
+	*openapi3.Operation
+
+	// route is what openapi3filter validates the operation's requests and responses against.
+	route *routers.Route
+}
+
+// RequiresAuth reports whether every security alternative of the operation needs credentials.
+// An operation without requirements, or with an empty alternative (`- {}`), is public.
+func (o *Operation) RequiresAuth() bool {
+	if len(o.Security) == 0 {
+		return false
+	}
+	for _, req := range o.Security {
+		if len(req) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Index looks up operations by HTTP method and gin route template, or by operation ID.
+type Index struct {
+	byRoute map[string]*Operation
+	byID    map[string]*Operation
+}
+
+// NewIndex indexes every operation of spec as registered by schemas.RegisterHandlersWithOptions under baseURL.
+func NewIndex(spec *openapi3.T, baseURL string) *Index {
+	idx := &Index{byRoute: map[string]*Operation{}, byID: map[string]*Operation{}}
+	if spec == nil || spec.Paths == nil {
+		return idx
+	}
+	for path, item := range spec.Paths.Map() {
+		route := baseURL + ginRoute(path)
+		for method, op := range item.Operations() {
+			security := spec.Security
+			if op.Security != nil {
+				security = *op.Security
+			}
+			o := &Operation{
+				Method:    method,
+				Path:      path,
+				Route:     route,
+				Security:  security,
+				Operation: op,
+				route:     &routers.Route{Spec: spec, Path: path, PathItem: item, Method: method, Operation: op},
+			}
+			idx.byRoute[key(method, route)] = o
+			if op.OperationID != "" {
+				idx.byID[op.OperationID] = o
+			}
+		}
+	}
+	return idx
+}
+
+// Lookup returns the operation registered for method and gin route template.
+func (i *Index) Lookup(method, route string) (*Operation, bool) {
+	op, ok := i.byRoute[key(method, route)]
+	return op, ok
+}
+
+// ByOperationID returns the operation with the given operationId, as passed to strict middlewares.
+func (i *Index) ByOperationID(id string) (*Operation, bool) {
+	op, ok := i.byID[id]
+	return op, ok
+}
+
+// ForContext returns the operation matched by the current request, if any.
+func (i *Index) ForContext(c *gin.Context) (*Operation, bool) {
+	return i.Lookup(c.Request.Method, c.FullPath())
+}
+
+func key(method, route string) string {
+	return strings.ToUpper(method) + " " + route
+}
+
+// ginRoute converts an OpenAPI path template (/users/{user_id}) to gin syntax (/users/:user_id).
+func ginRoute(path string) string {
+	segs := strings.Split(path, "/")
+	for i, s := range segs {
+		if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+			segs[i] = ":" + s[1:len(s)-1]
+		}
+	}
+	return strings.Join(segs, "/")
+}
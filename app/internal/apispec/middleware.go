@@ -0,0 +1,47 @@
+package apispec
+
+import (
+	"io"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LiteralColons rejects requests that only matched because gin reads a colon inside a path segment
+// (e.g. /todos:batch) as a parameter, so /todosX would otherwise reach the batch operation. onNotFound
+// writes the response. Register it as a server middleware, before the generated wrapper runs.
+func (i *Index) LiteralColons(onNotFound func(c *gin.Context)) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		op, ok := i.ForContext(c)
+		if !ok {
+			return
+		}
+		route := strings.Split(op.Route, "/")
+		path := strings.Split(c.Request.URL.Path, "/")
+		if len(route) != len(path) {
+			return
+		}
+		for n, seg := range route {
+			if strings.IndexByte(seg, ':') > 0 && seg != path[n] {
+				onNotFound(c)
+				c.Abort()
+				return
+			}
+		}
+	}
+}
+
+// OptionalBodies lets an empty body through as {} for operations whose requestBody is not required:
+// the generated strict wrapper always decodes a JSON body and would reject it.
+func (i *Index) OptionalBodies() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		op, ok := i.ForContext(c)
+		if !ok || op.RequestBody == nil || op.RequestBody.Value == nil || op.RequestBody.Value.Required {
+			return
+		}
+		if c.Request.ContentLength == 0 {
+			c.Request.Body = io.NopCloser(strings.NewReader("{}"))
+			c.Request.ContentLength = 2
+		}
+	}
+}
@@ -0,0 +1,332 @@
+package apispec
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/gin-gonic/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func init() {
+	// kin-openapi leaves format: email unchecked unless it is defined. Use the check of the generated
+	// openapi_types.Email, so nothing that passes here fails when the handler's request is decoded.
+	openapi3.DefineStringFormatValidator("email", openapi3.NewCallbackValidator(func(s string) error {
+		var e openapi_types.Email
+		if e.UnmarshalJSON([]byte(strconv.Quote(s))) != nil {
+			return errors.New("not an email address")
+		}
+		return nil
+	}))
+}
+
+// RequestError is a request that does not match its operation in the spec.
+type RequestError struct {
+	// Status is the answer: 401 (no credentials), 415 (undeclared content type) or 400.
+	Status int
+	// Fields lists every value that failed its schema. Without them the request as a whole is
+	// rejected for Reason (e.g. a body that is not JSON).
+	Fields []FieldError
+	Reason string
+	// MediaTypes are the request body types the operation accepts, set when the content type is not one of them.
+	MediaTypes []string
+}
+
+// FieldError is one value that does not match its schema.
+type FieldError struct {
+	// Field is the parameter name or the path in the body, e.g. operations.0.create.title; "" is the body itself.
+	Field string
+	// Rule is the schema keyword that failed: required, maxLength, enum, pattern, format, type, ...
+	Rule string
+	// Param is the keyword's value in the schema (30 for maxLength: 30, the values of enum); nil if it has none.
+	Param   any
+	Message string
+}
+
+func (e *RequestError) Error() string {
+	if len(e.Fields) == 0 {
+		return e.Reason
+	}
+	msgs := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		msgs = append(msgs, f.Field+": "+f.Message)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ValidateRequests checks parameters, the body (type, required, minLength/maxLength, pattern, enum, format)
+// and the presence of credentials against the matched operation; onInvalid writes the response and the
+// request is aborted. Register it as a server middleware after auth.Middleware, which verifies the
+// credentials first (only their presence is checked here), and before OptionalBodies (an omitted optional
+// body is valid as is).
+func (i *Index) ValidateRequests(onInvalid func(c *gin.Context, err *RequestError)) func(c *gin.Context) {
+	opts := &openapi3filter.Options{
+		AuthenticationFunc: bearerPresent,
+		// Handlers tell an omitted field from its default (merge patches, partial updates).
+		SkipSettingDefaults: true,
+		MultiError:          true,
+	}
+	return func(c *gin.Context) {
+		op, ok := i.ForContext(c)
+		if !ok {
+			return
+		}
+		params := make(map[string]string, len(c.Params))
+		for _, p := range c.Params {
+			params[p.Key] = p.Value
+		}
+		err := openapi3filter.ValidateRequest(c.Request.Context(), &openapi3filter.RequestValidationInput{
+			Request:    c.Request,
+			PathParams: params,
+			Route:      op.route,
+			Options:    opts,
+		})
+		if err != nil {
+			onInvalid(c, requestError(op, err))
+			c.Abort()
+		}
+	}
+}
+
+func bearerPresent(_ context.Context, in *openapi3filter.AuthenticationInput) error {
+	scheme := in.SecurityScheme
+	if scheme == nil || scheme.Type != "http" || !strings.EqualFold(scheme.Scheme, "bearer") {
+		return in.NewError(errors.New("unsupported security scheme " + in.SecuritySchemeName))
+	}
+	kind, token, _ := strings.Cut(in.RequestValidationInput.Request.Header.Get("Authorization"), " ")
+	if !strings.EqualFold(kind, "Bearer") || strings.TrimSpace(token) == "" {
+		return in.NewError(nil)
+	}
+	return nil
+}
+
+func requestError(op *Operation, err error) *RequestError {
+	out := &RequestError{Status: http.StatusBadRequest}
+	var walk func(err error)
+	walk = func(err error) {
+		// Type switches, not errors.As: a MultiError would match any of the types it holds.
+		var re *openapi3filter.RequestError
+		switch e := err.(type) {
+		case openapi3.MultiError:
+			for _, err := range e {
+				walk(err)
+			}
+			return
+		case *openapi3filter.SecurityRequirementsError:
+			out.Status = http.StatusUnauthorized
+			return
+		case *openapi3filter.RequestError:
+			re = e
+		default:
+			out.Reason = err.Error()
+			return
+		}
+		var field string
+		if re.Parameter != nil {
+			field = re.Parameter.Name
+		}
+		switch {
+		case re.RequestBody != nil && re.Err == nil:
+			// Content-Type is not one of the operation's request body media types.
+			types := make([]string, 0, len(re.RequestBody.Content))
+			for mt := range re.RequestBody.Content {
+				types = append(types, mt)
+			}
+			slices.Sort(types)
+			if op.Responses.Status(http.StatusUnsupportedMediaType) != nil {
+				out.Status = http.StatusUnsupportedMediaType
+			}
+			out.MediaTypes = types
+			out.Reason = "content type must be one of " + strings.Join(types, ", ")
+		case errors.Is(re.Err, openapi3filter.ErrInvalidRequired):
+			msg := "value is required"
+			if re.RequestBody != nil {
+				msg = "request body is required"
+			}
+			out.Fields = append(out.Fields, FieldError{Field: field, Rule: "required", Message: msg})
+		case hasSchemaErrors(re.Err):
+			schemaErrors(re.Err, func(sch *openapi3.SchemaError) {
+				name := field
+				if path := sch.JSONPointer(); len(path) > 0 {
+					if name != "" {
+						name += "."
+					}
+					name += strings.Join(path, ".")
+				}
+				out.Fields = append(out.Fields, FieldError{
+					Field:   name,
+					Rule:    sch.SchemaField,
+					Param:   schemaParam(sch.Schema, sch.SchemaField),
+					Message: sch.Reason,
+				})
+			})
+		case re.RequestBody != nil:
+			out.Reason = "invalid json"
+		default:
+			// A parameter that could not be parsed as its schema's type.
+			out.Fields = append(out.Fields, FieldError{Field: field, Rule: "type", Message: re.Error()})
+		}
+	}
+	walk(err)
+	return out
+}
+
+// schemaParam returns the value of the keyword rule in s.
+func schemaParam(s *openapi3.Schema, rule string) any {
+	if s == nil {
+		return nil
+	}
+	switch rule {
+	case "minLength":
+		return s.MinLength
+	case "maxLength":
+		if s.MaxLength != nil {
+			return *s.MaxLength
+		}
+	case "minItems":
+		return s.MinItems
+	case "maxItems":
+		if s.MaxItems != nil {
+			return *s.MaxItems
+		}
+	case "minimum":
+		if s.Min != nil {
+			return *s.Min
+		}
+	case "maximum":
+		if s.Max != nil {
+			return *s.Max
+		}
+	case "enum":
+		return s.Enum
+	case "pattern":
+		return s.Pattern
+	case "format":
+		return s.Format
+	case "type":
+		if s.Type != nil {
+			return s.Type.Slice()
+		}
+	}
+	return nil
+}
+
+func hasSchemaErrors(err error) bool {
+	found := false
+	schemaErrors(err, func(*openapi3.SchemaError) { found = true })
+	return found
+}
+
+// schemaErrors calls fn for every SchemaError in err, which may be a MultiError of them.
+func schemaErrors(err error, fn func(*openapi3.SchemaError)) {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, err := range e {
+			schemaErrors(err, fn)
+		}
+	case *openapi3.SchemaError:
+		fn(e)
+	}
+}
+
+// ValidateResponses checks each response of an operation (status, content type, body) against the spec
+// and logs a mismatch. With fail, onInvalid writes the response instead (typically a 500), so tests and
+// dev environments notice; the handler's original response is dropped. The response is buffered until
+// the handlers return. Register it with gin's Use, before middlewares that write error responses.
+func (i *Index) ValidateResponses(fail bool, onInvalid func(c *gin.Context, err error)) gin.HandlerFunc {
+	opts := &openapi3filter.Options{IncludeResponseStatus: true}
+	return func(c *gin.Context) {
+		op, ok := i.ForContext(c)
+		if !ok {
+			c.Next()
+			return
+		}
+		w := &bufferedWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		// Restored on a panic too, so the recovery middleware's 500 is not held in the buffer.
+		defer func() { c.Writer = w.ResponseWriter }()
+		c.Next()
+		c.Writer = w.ResponseWriter
+
+		status := w.Status()
+		params := make(map[string]string, len(c.Params))
+		for _, p := range c.Params {
+			params[p.Key] = p.Value
+		}
+		in := &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: &openapi3filter.RequestValidationInput{
+				Request:    c.Request,
+				PathParams: params,
+				Route:      op.route,
+			},
+			Status:  status,
+			Header:  c.Writer.Header(),
+			Options: opts,
+		}
+		in.SetBodyBytes(w.body.Bytes())
+		if err := openapi3filter.ValidateResponse(c.Request.Context(), in); err != nil {
+			slog.ErrorContext(c, "response does not match the spec", "operation", op.OperationID, "status", status, "err", err)
+			if fail {
+				onInvalid(c, err)
+				return
+			}
+		}
+
+		c.Writer.WriteHeader(status)
+		if w.body.Len() == 0 {
+			c.Writer.WriteHeaderNow()
+			return
+		}
+		_, _ = c.Writer.Write(w.body.Bytes())
+	}
+}
+
+// bufferedWriter holds a response back until ValidateResponses has checked it.
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status  int
+	written bool
+	body    bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	if code > 0 {
+		w.status = code
+	}
+}
+
+func (w *bufferedWriter) WriteHeaderNow() { w.written = true }
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	w.written = true
+	return w.body.Write(b)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	w.written = true
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	if !w.written {
+		return -1
+	}
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool { return w.written }
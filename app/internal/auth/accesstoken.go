@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"log/slog"
+	"slices"
+)
+
+// AccessTokenPrefix marks personal access tokens so they can be told apart from JWTs.
+const AccessTokenPrefix = "pat_"
+
+type Scope string
+
+const (
+	ScopeTodosRead  Scope = "todos:read"
+	ScopeTodosWrite Scope = "todos:write"
+
+	// ScopeAll is implied by interactive sessions (Firebase / dev tokens).
+	// Personal access tokens can never hold it, so it guards account-level operations.
+	ScopeAll Scope = "*"
+)
+
+// AccessTokenScopes are the scopes a personal access token may be created with.
+var AccessTokenScopes = []Scope{ScopeTodosRead, ScopeTodosWrite}
+
+// AccessTokenStore looks up personal access tokens by the SHA-256 hash of their secret.
+type AccessTokenStore interface {
+	// LookupAccessToken returns the unexpired token with the given hash; found is false if there is none
+	// or its owner is disabled.
+	LookupAccessToken(ctx context.Context, hash string) (tokenID, uid string, scopes []string, found bool, err error)
+	MarkAccessTokenUsed(ctx context.Context, tokenID string) error
+}
+
+// NewAccessToken generates a token secret and the hash to persist. The secret is shown to the user once.
+func NewAccessToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return token, HashAccessToken(token), nil
+}
+
+func HashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// HasScope reports whether the principal may perform operations requiring s.
+// Session principals (Scopes == nil) hold every scope.
+func (p *Principal) HasScope(s Scope) bool {
+	return p.Scopes == nil || slices.Contains(p.Scopes, s)
+}
+
+func (v *Verifier) authenticateAccessToken(ctx context.Context, token string) (*Principal, error) {
+	if v.tokens == nil {
+		return nil, ErrUnauthorized
+	}
+	id, uid, scopes, found, err := v.tokens.LookupAccessToken(ctx, HashAccessToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrUnauthorized
+	}
+	if err := v.tokens.MarkAccessTokenUsed(ctx, id); err != nil {
+		// Bookkeeping only; don't fail the request over it.
+		slog.WarnContext(ctx, "record access token use", "token_id", id, "err", err)
+	}
+	p := &Principal{
+		UID:     uid,
+		Claims:  map[string]any{"uid": uid, "token_id": id},
+		TokenID: id,
+		Scopes:  make([]Scope, 0, len(scopes)),
+	}
+	for _, s := range scopes {
+		p.Scopes = append(p.Scopes, Scope(s))
+	}
+	return p, nil
+}
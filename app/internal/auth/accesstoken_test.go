@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go-gin-webapi/internal/config"
+)
+
+// fakeTokens is an AccessTokenStore of tokens by hash.
+type fakeTokens struct {
+	byHash map[string]fakeToken
+	used   []string
+	err    error
+}
+
+type fakeToken struct {
+	id, uid string
+	scopes  []string
+	// disabled stands for an expired token or a disabled owner, which the store does not return.
+	disabled bool
+}
+
+func (f *fakeTokens) LookupAccessToken(_ context.Context, hash string) (string, string, []string, bool, error) {
+	if f.err != nil {
+		return "", "", nil, false, f.err
+	}
+	t, ok := f.byHash[hash]
+	if !ok || t.disabled {
+		return "", "", nil, false, nil
+	}
+	return t.id, t.uid, t.scopes, true, nil
+}
+
+func (f *fakeTokens) MarkAccessTokenUsed(_ context.Context, id string) error {
+	f.used = append(f.used, id)
+	return nil
+}
+
+func TestNewAccessToken(t *testing.T) {
+	token, hash, err := NewAccessToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(token, AccessTokenPrefix) {
+		t.Errorf("token %q lacks the %q prefix", token, AccessTokenPrefix)
+	}
+	if hash != HashAccessToken(token) || len(hash) != 64 {
+		t.Errorf("hash = %q, want the hex SHA-256 of the token", hash)
+	}
+	other, _, err := NewAccessToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == token {
+		t.Error("two tokens are equal")
+	}
+}
+
+func TestAuthenticateAccessToken(t *testing.T) {
+	const (
+		readToken     = "pat_read"
+		writeToken    = "pat_write"
+		disabledToken = "pat_disabled"
+	)
+	store := &fakeTokens{byHash: map[string]fakeToken{
+		HashAccessToken(readToken):     {id: "t1", uid: "alice", scopes: []string{"todos:read"}},
+		HashAccessToken(writeToken):    {id: "t2", uid: "bob", scopes: []string{"todos:read", "todos:write"}},
+		HashAccessToken(disabledToken): {id: "t3", uid: "carol", scopes: []string{"todos:read"}, disabled: true},
+	}}
+	v := NewVerifier(nil, nil, config.AuthConfig{}, nil, store)
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantUID       string
+		wantScopes    []Scope
+		wantErr       error
+	}{
+		{name: "read", authorization: "Bearer " + readToken, wantUID: "alice", wantScopes: []Scope{ScopeTodosRead}},
+		{name: "read and write", authorization: "Bearer " + writeToken, wantUID: "bob", wantScopes: []Scope{ScopeTodosRead, ScopeTodosWrite}},
+		{name: "unknown", authorization: "Bearer pat_unknown", wantErr: ErrUnauthorized},
+		{name: "disabled or expired", authorization: "Bearer " + disabledToken, wantErr: ErrUnauthorized},
+		{name: "hash instead of token", authorization: "Bearer pat_" + HashAccessToken(readToken), wantErr: ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := v.Authenticate(context.Background(), tt.authorization)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.UID != tt.wantUID || !reflect.DeepEqual(p.Scopes, tt.wantScopes) {
+				t.Errorf("principal = %s %v, want %s %v", p.UID, p.Scopes, tt.wantUID, tt.wantScopes)
+			}
+			if p.HasScope(ScopeAll) {
+				t.Error("an access token holds ScopeAll")
+			}
+		})
+	}
+	if want := []string{"t1", "t2"}; !reflect.DeepEqual(store.used, want) {
+		t.Errorf("marked used = %v, want %v", store.used, want)
+	}
+}
+
+func TestAuthenticateAccessTokenStoreError(t *testing.T) {
+	broken := errors.New("db down")
+	v := NewVerifier(nil, nil, config.AuthConfig{}, nil, &fakeTokens{err: broken})
+	if _, err := v.Authenticate(context.Background(), "Bearer pat_x"); !errors.Is(err, broken) {
+		t.Errorf("err = %v, want the store's error (500, not 401)", err)
+	}
+}
+
+func TestHasScope(t *testing.T) {
+	tests := []struct {
+		name   string
+		scopes []Scope
+		scope  Scope
+		want   bool
+	}{
+		{name: "session holds everything", scopes: nil, scope: ScopeAll, want: true},
+		{name: "granted", scopes: []Scope{ScopeTodosRead}, scope: ScopeTodosRead, want: true},
+		{name: "not granted", scopes: []Scope{ScopeTodosRead}, scope: ScopeTodosWrite, want: false},
+		{name: "no scopes", scopes: []Scope{}, scope: ScopeTodosRead, want: false},
+		{name: "account operations", scopes: []Scope{ScopeTodosRead, ScopeTodosWrite}, scope: ScopeAll, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Principal{UID: "alice", Scopes: tt.scopes}
+			if got := p.HasScope(tt.scope); got != tt.want {
+				t.Errorf("HasScope(%q) = %v, want %v", tt.scope, got, tt.want)
+			}
+		})
+	}
+}
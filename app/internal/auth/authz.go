@@ -4,9 +4,9 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	fbauth "firebase.google.com/go/v4/auth"
-	"github.com/gin-gonic/gin"
 
 	"go-gin-webapi/internal/config"
 )
@@ -15,56 +15,116 @@ type Verifier struct {
 	admin    *FirebaseAdmin
 	adminErr error
 	cfg      config.AuthConfig
+	roles    RoleStore
+	tokens   AccessTokenStore
+	revoked  *revocationCache
 }
 
-func NewVerifier(admin *FirebaseAdmin, adminErr error, cfg config.AuthConfig) *Verifier {
-	return &Verifier{admin: admin, adminErr: adminErr, cfg: cfg}
+// NewVerifier builds a Verifier. roles is consulted for dev-token principals; Firebase principals use custom claims.
+// tokens backs personal access tokens (pat_...).
+func NewVerifier(admin *FirebaseAdmin, adminErr error, cfg config.AuthConfig, roles RoleStore, tokens AccessTokenStore) *Verifier {
+	return &Verifier{
+		admin:    admin,
+		adminErr: adminErr,
+		cfg:      cfg,
+		roles:    roles,
+		tokens:   tokens,
+		revoked:  newRevocationCache(cfg.RevocationCacheTTL),
+	}
 }
 
 var ErrUnauthorized = errors.New("unauthorized")
 
-func (v *Verifier) RequireUID(c *gin.Context) (string, error) {
-	if v.cfg.Bypass {
-		if uid := strings.TrimSpace(c.GetHeader("X-User-Id")); uid != "" {
-			return uid, nil
-		}
+// Authenticate verifies the bearer token in an Authorization header value.
+// Token missing/invalid -> ErrUnauthorized; any other error means the verifier itself is misconfigured.
+func (v *Verifier) Authenticate(ctx context.Context, authorization string) (*Principal, error) {
+	token, ok := bearerToken(authorization)
+	if !ok {
+		return nil, ErrUnauthorized
 	}
-
-	h := c.GetHeader("Authorization")
-	if h == "" {
-		return "", ErrUnauthorized
+	if strings.HasPrefix(token, AccessTokenPrefix) {
+		return v.authenticateAccessToken(ctx, token)
 	}
-	parts := strings.SplitN(h, " ", 2)
-	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
-		return "", ErrUnauthorized
-	}
-	token := strings.TrimSpace(parts[1])
-	if token == "" {
-		return "", ErrUnauthorized
+	if strings.HasPrefix(token, DevTokenPrefix) {
+		// Dev tokens are only honored in bypass mode; otherwise they are just invalid bearer tokens.
+		if !v.cfg.Bypass {
+			return nil, ErrUnauthorized
+		}
+		p, err := parseDevPrincipal([]byte(v.cfg.DevTokenSecret), token)
+		if err != nil {
+			return nil, err
+		}
+		if v.roles != nil {
+			roles, err := v.roles.RolesByUID(ctx, p.UID)
+			if err != nil {
+				return nil, err
+			}
+			p.Roles = toRoles(roles)
+		}
+		return p, nil
 	}
 	if v.admin == nil || v.admin.Auth == nil {
 		if v.adminErr != nil {
-			return "", v.adminErr
+			return nil, v.adminErr
 		}
-		return "", errors.New("firebase admin not configured")
+		return nil, errors.New("firebase admin not configured")
 	}
-	t, err := v.admin.Auth.VerifyIDToken(context.Background(), token)
+	// Signature/expiry are checked locally; revocation needs the user record, so consult the cache first.
+	t, err := v.admin.Auth.VerifyIDToken(ctx, token)
 	if err != nil {
-		// Known "token is not acceptable" cases -> 401.
-		// Anything else likely indicates server-side misconfiguration (project id/credentials/network),
-		// so return the underlying error to help debugging (handler will map it to 500).
-		if fbauth.IsIDTokenInvalid(err) ||
-			fbauth.IsIDTokenExpired(err) ||
-			fbauth.IsIDTokenRevoked(err) ||
-			fbauth.IsUserDisabled(err) {
-			return "", ErrUnauthorized
-		}
-		return "", err
+		return nil, idTokenError(err)
 	}
 	if t == nil || t.UID == "" {
-		return "", ErrUnauthorized
+		return nil, ErrUnauthorized
+	}
+	issuedAt := time.Unix(t.IssuedAt, 0)
+	switch v.revoked.check(t.UID, issuedAt) {
+	case revocationValid:
+		return firebasePrincipal(t), nil
+	case revocationRevoked:
+		return nil, ErrUnauthorized
+	}
+	t, err = v.admin.Auth.VerifyIDTokenAndCheckRevoked(ctx, token)
+	if err != nil {
+		return nil, idTokenError(err)
 	}
-	return t.UID, nil
+	v.revoked.markValid(t.UID, issuedAt)
+	return firebasePrincipal(t), nil
 }
 
+// RevokeSessions revokes uid's refresh tokens and makes this instance reject their existing ID tokens immediately.
+// Other instances notice once their cached entry expires (AuthConfig.RevocationCacheTTL).
+func (v *Verifier) RevokeSessions(ctx context.Context, uid string) error {
+	if v.admin == nil || v.admin.Auth == nil {
+		// Nothing to revoke without Firebase (dev tokens are stateless).
+		return nil
+	}
+	if err := v.admin.Auth.RevokeRefreshTokens(ctx, uid); err != nil {
+		return err
+	}
+	v.revoked.invalidate(uid)
+	return nil
+}
 
+// idTokenError maps Firebase verification errors.
+// Known "token is not acceptable" cases -> 401.
+// Anything else likely indicates server-side misconfiguration (project id/credentials/network),
+// so return the underlying error to help debugging (handler will map it to 500).
+func idTokenError(err error) error {
+	if fbauth.IsIDTokenInvalid(err) ||
+		fbauth.IsIDTokenExpired(err) ||
+		fbauth.IsIDTokenRevoked(err) ||
+		fbauth.IsUserDisabled(err) {
+		return ErrUnauthorized
+	}
+	return err
+}
+
+func bearerToken(h string) (string, bool) {
+	parts := strings.SplitN(h, " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return "", false
+	}
+	token := strings.TrimSpace(parts[1])
+	return token, token != ""
+}
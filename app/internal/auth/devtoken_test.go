@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go-gin-webapi/internal/config"
+)
+
+func TestDevToken(t *testing.T) {
+	secret := []byte("secret")
+	valid, err := MintDevToken(secret, " alice ", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired, err := MintDevToken(secret, "alice", -time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, sig, _ := strings.Cut(strings.TrimPrefix(valid, DevTokenPrefix), ".")
+	forged := DevTokenPrefix + base64.RawURLEncoding.EncodeToString([]byte(`{"uid":"mallory","exp":9999999999}`)) + "." + sig
+
+	tests := []struct {
+		name    string
+		secret  []byte
+		token   string
+		wantUID string
+		wantErr error
+	}{
+		{name: "valid", secret: secret, token: valid, wantUID: "alice"},
+		{name: "other secret", secret: []byte("other"), token: valid, wantErr: ErrUnauthorized},
+		{name: "expired", secret: secret, token: expired, wantErr: ErrUnauthorized},
+		{name: "payload swapped", secret: secret, token: forged, wantErr: ErrUnauthorized},
+		{name: "signature missing", secret: secret, token: DevTokenPrefix + payload, wantErr: ErrUnauthorized},
+		{name: "no prefix", secret: secret, token: strings.TrimPrefix(valid, DevTokenPrefix), wantErr: ErrUnauthorized},
+		{name: "garbage", secret: secret, token: DevTokenPrefix + "!!.!!", wantErr: ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := parseDevPrincipal(tt.secret, tt.token)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.UID != tt.wantUID {
+				t.Errorf("UID = %q, want %q", p.UID, tt.wantUID)
+			}
+			if p.Scopes != nil {
+				t.Errorf("Scopes = %v, want nil (every scope)", p.Scopes)
+			}
+		})
+	}
+}
+
+func TestMintDevTokenRejects(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret []byte
+		uid    string
+	}{
+		{name: "empty secret", uid: "alice"},
+		{name: "blank uid", secret: []byte("secret"), uid: "  "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := MintDevToken(tt.secret, tt.uid, time.Hour); err == nil {
+				t.Error("MintDevToken succeeded")
+			}
+		})
+	}
+}
+
+type fakeRoles map[string][]string
+
+func (f fakeRoles) RolesByUID(_ context.Context, uid string) ([]string, error) { return f[uid], nil }
+
+func TestAuthenticateDevToken(t *testing.T) {
+	const secret = "secret"
+	token, err := MintDevToken([]byte(secret), "alice", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roles := fakeRoles{"alice": {"admin"}}
+
+	tests := []struct {
+		name          string
+		bypass        bool
+		authorization string
+		wantErr       error
+	}{
+		{name: "bypass", bypass: true, authorization: "Bearer " + token},
+		{name: "scheme is case-insensitive", bypass: true, authorization: "bearer  " + token},
+		{name: "without bypass", authorization: "Bearer " + token, wantErr: ErrUnauthorized},
+		{name: "no scheme", bypass: true, authorization: token, wantErr: ErrUnauthorized},
+		{name: "basic", bypass: true, authorization: "Basic " + token, wantErr: ErrUnauthorized},
+		{name: "empty token", bypass: true, authorization: "Bearer  ", wantErr: ErrUnauthorized},
+		{name: "no header", bypass: true, wantErr: ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewVerifier(nil, nil, config.AuthConfig{Bypass: tt.bypass, DevTokenSecret: secret}, roles, nil)
+			p, err := v.Authenticate(context.Background(), tt.authorization)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.UID != "alice" || len(p.Roles) != 1 || p.Roles[0] != "admin" {
+				t.Errorf("principal = %+v, want alice with role admin", p)
+			}
+		})
+	}
+}
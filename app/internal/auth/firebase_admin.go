@@ -3,11 +3,14 @@ package auth
 import (
 	"context"
 	"errors"
+	"net/http"
 	"os"
 
 	firebase "firebase.google.com/go/v4"
 	"firebase.google.com/go/v4/auth"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
 
 	"go-gin-webapi/internal/config"
 )
@@ -32,6 +35,17 @@ func NewFirebaseAdmin(ctx context.Context, cfg config.FirebaseConfig) (*Firebase
 		// (e.g., running on GCP). In local devcontainer this is usually missing.
 	}
 
+	// Trace the Admin API calls like IdentityToolkit's. WithHTTPClient replaces the credentials, so they are
+	// applied to the traced transport here. The emulator ignores these options and uses its own client.
+	if cfg.AuthEmulatorHostport == "" {
+		base := otelhttp.NewTransport(http.DefaultTransport, otelhttp.WithSpanNameFormatter(googleSpanName))
+		rt, err := htransport.NewTransport(ctx, base, append(opts, option.WithTelemetryDisabled())...)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, option.WithHTTPClient(&http.Client{Transport: rt}))
+	}
+
 	app, err := firebase.NewApp(ctx, &firebase.Config{ProjectID: cfg.ProjectID}, opts...)
 	if err != nil {
 		return nil, err
@@ -7,7 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"go-gin-webapi/internal/metrics"
 )
 
 type IdentityToolkitClient struct {
@@ -19,70 +26,130 @@ func NewIdentityToolkitClient(apiKey string) *IdentityToolkitClient {
 	return &IdentityToolkitClient{
 		apiKey: apiKey,
 		http: &http.Client{
-			Timeout: 15 * time.Second,
+			Timeout:   15 * time.Second,
+			Transport: otelhttp.NewTransport(http.DefaultTransport, otelhttp.WithSpanNameFormatter(googleSpanName)),
 		},
 	}
 }
 
-type idtkAuthResponse struct {
-	LocalID      string `json:"localId"`
-	IDToken      string `json:"idToken"`
-	RefreshToken string `json:"refreshToken"`
-	Email        string `json:"email"`
-	Error        *struct {
+// googleSpanName names the span of a Google API call by service and method, e.g. "identitytoolkit accounts:signUp".
+func googleSpanName(_ string, r *http.Request) string {
+	return strings.TrimSuffix(r.URL.Host, ".googleapis.com") + " " + strings.TrimPrefix(r.URL.Path, "/v1/")
+}
+
+type idtkErrorResponse struct {
+	Error *struct {
 		Message string `json:"message"`
-	} `json:"error,omitempty"`
+	} `json:"error"`
 }
 
-func (c *IdentityToolkitClient) SignUp(ctx context.Context, email, password string) (uid, idToken, refreshToken string, err error) {
-	return c.call(ctx, "accounts:signUp", email, password)
+// IdentityToolkitError is a request IdentityToolkit or the Secure Token API rejected (HTTP 4xx): bad credentials,
+// a taken email, an expired refresh token, ...
+type IdentityToolkitError struct {
+	Status int
+	// Code is the error's leading word, e.g. EMAIL_EXISTS, INVALID_LOGIN_CREDENTIALS or WEAK_PASSWORD.
+	Code    string
+	Message string
 }
 
-func (c *IdentityToolkitClient) SignInWithPassword(ctx context.Context, email, password string) (uid, idToken, refreshToken string, err error) {
-	return c.call(ctx, "accounts:signInWithPassword", email, password)
+func (e *IdentityToolkitError) Error() string { return "identitytoolkit: " + e.Message }
+
+func (c *IdentityToolkitClient) SignUp(ctx context.Context, email, password string) (uid, idToken, refreshToken string, err error) {
+	return c.call(ctx, "accounts:signUp", map[string]any{
+		"email":             email,
+		"password":          password,
+		"returnSecureToken": true,
+	})
 }
 
-func (c *IdentityToolkitClient) call(ctx context.Context, method, email, password string) (uid, idToken, refreshToken string, err error) {
-	if c.apiKey == "" {
-		return "", "", "", errors.New("FIREBASE_API_KEY is required for register/login")
-	}
+// SignUpAnonymous creates an anonymous Firebase user (accounts:signUp without credentials).
+func (c *IdentityToolkitClient) SignUpAnonymous(ctx context.Context) (uid, idToken, refreshToken string, err error) {
+	return c.call(ctx, "accounts:signUp", map[string]any{
+		"returnSecureToken": true,
+	})
+}
 
-	body := map[string]any{
+func (c *IdentityToolkitClient) SignInWithPassword(ctx context.Context, email, password string) (uid, idToken, refreshToken string, err error) {
+	return c.call(ctx, "accounts:signInWithPassword", map[string]any{
 		"email":             email,
 		"password":          password,
 		"returnSecureToken": true,
+	})
+}
+
+// Refresh exchanges a refresh token for a new ID token (Secure Token API). Firebase may rotate the refresh token,
+// so the returned one replaces the old.
+func (c *IdentityToolkitClient) Refresh(ctx context.Context, refreshToken string) (uid, idToken, newRefreshToken string, expiresIn time.Duration, err error) {
+	form := url.Values{"grant_type": {"refresh_token"}, "refresh_token": {refreshToken}}
+	var out struct {
+		UserID       string `json:"user_id"`
+		IDToken      string `json:"id_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    string `json:"expires_in"` // seconds, as a string
+	}
+	if err := c.post(ctx, "token", "https://securetoken.googleapis.com/v1/token", "application/x-www-form-urlencoded", []byte(form.Encode()), &out); err != nil {
+		return "", "", "", 0, err
 	}
+	if out.UserID == "" || out.IDToken == "" || out.RefreshToken == "" {
+		return "", "", "", 0, errors.New("securetoken: unexpected empty response")
+	}
+	secs, err := strconv.Atoi(out.ExpiresIn)
+	if err != nil {
+		return "", "", "", 0, fmt.Errorf("securetoken: expires_in %q: %w", out.ExpiresIn, err)
+	}
+	return out.UserID, out.IDToken, out.RefreshToken, time.Duration(secs) * time.Second, nil
+}
+
+func (c *IdentityToolkitClient) call(ctx context.Context, method string, body map[string]any) (uid, idToken, refreshToken string, err error) {
 	b, _ := json.Marshal(body)
+	var out struct {
+		LocalID      string `json:"localId"`
+		IDToken      string `json:"idToken"`
+		RefreshToken string `json:"refreshToken"`
+	}
+	if err := c.post(ctx, method, "https://identitytoolkit.googleapis.com/v1/"+method, "application/json", b, &out); err != nil {
+		return "", "", "", err
+	}
+	if out.LocalID == "" || out.IDToken == "" || out.RefreshToken == "" {
+		return "", "", "", errors.New("identitytoolkit: unexpected empty response")
+	}
+	return out.LocalID, out.IDToken, out.RefreshToken, nil
+}
+
+// post sends one request and decodes a successful response into out.
+func (c *IdentityToolkitClient) post(ctx context.Context, method, endpoint, contentType string, body []byte, out any) (err error) {
+	if c.apiKey == "" {
+		return errors.New("FIREBASE_API_KEY is required for register/login")
+	}
+
+	start := time.Now()
+	defer func() { metrics.ObserveIdentityToolkit(method, time.Since(start), err) }()
 
-	url := fmt.Sprintf("https://identitytoolkit.googleapis.com/v1/%s?key=%s", method, c.apiKey)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
 	if err != nil {
-		return "", "", "", err
+		return err
 	}
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
+	// Header rather than ?key= so the key stays out of span attributes and error messages.
+	req.Header.Set("X-Goog-Api-Key", c.apiKey)
 
 	res, err := c.http.Do(req)
 	if err != nil {
-		return "", "", "", err
+		return err
 	}
 	defer res.Body.Close()
 
-	var out idtkAuthResponse
-	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
-		return "", "", "", err
-	}
-
 	if res.StatusCode >= 400 {
-		if out.Error != nil && out.Error.Message != "" {
-			return "", "", "", fmt.Errorf("identitytoolkit: %s", out.Error.Message)
+		var e idtkErrorResponse
+		if err := json.NewDecoder(res.Body).Decode(&e); err != nil || e.Error == nil || e.Error.Message == "" {
+			return fmt.Errorf("identitytoolkit: http %d", res.StatusCode)
 		}
-		return "", "", "", fmt.Errorf("identitytoolkit: http %d", res.StatusCode)
-	}
-
-	if out.LocalID == "" || out.IDToken == "" || out.RefreshToken == "" {
-		return "", "", "", errors.New("identitytoolkit: unexpected empty response")
+		if res.StatusCode >= 500 {
+			return fmt.Errorf("identitytoolkit: %s", e.Error.Message)
+		}
+		// e.g. "WEAK_PASSWORD : Password should be at least 6 characters"
+		code, _, _ := strings.Cut(e.Error.Message, " ")
+		return &IdentityToolkitError{Status: res.StatusCode, Code: code, Message: e.Error.Message}
 	}
-	return out.LocalID, out.IDToken, out.RefreshToken, nil
+	return json.NewDecoder(res.Body).Decode(out)
 }
-
-
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+
+	"go-gin-webapi/internal/apispec"
+	"go-gin-webapi/internal/logging"
+	"go-gin-webapi/internal/metrics"
+)
+
+// Middleware authenticates requests to operations whose OpenAPI `security` requires a bearer token.
+// The verified Principal is stored on the context (see PrincipalFrom); handlers only do authorization.
+// Register it as a server middleware before apispec's ValidateRequests, so an unauthenticated caller
+// learns nothing about the operation's parameters and body. A failure aborts the request with the error
+// in c.Errors: ErrUnauthorized (401) or a verifier failure (500).
+func Middleware(v *Verifier, ops *apispec.Index) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		op, ok := ops.ForContext(c)
+		if !ok || !op.RequiresAuth() {
+			return
+		}
+		p, err := v.Authenticate(c.Request.Context(), c.GetHeader("Authorization"))
+		if err != nil {
+			if errors.Is(err, ErrUnauthorized) {
+				metrics.RecordAuth("authenticate", metrics.AuthUnauthorized)
+			} else {
+				metrics.RecordAuth("authenticate", metrics.AuthError)
+			}
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+		metrics.RecordAuth("authenticate", metrics.AuthAllowed)
+		SetPrincipal(c, p)
+		logging.SetUID(c.Request.Context(), p.UID)
+	}
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	fbauth "firebase.google.com/go/v4/auth"
+	"github.com/gin-gonic/gin"
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	UID    string
+	Claims map[string]any
+	// AuthTime is when the user last signed in (zero if unknown).
+	AuthTime time.Time
+	Roles    []Role
+	// Scopes restricts what the principal may do; nil means unrestricted (interactive session).
+	Scopes []Scope
+	// TokenID is set when authenticated with a personal access token.
+	TokenID string
+}
+
+const principalKey = "auth.principal"
+
+// SetPrincipal stores p on the request context.
+func SetPrincipal(c *gin.Context, p *Principal) {
+	c.Set(principalKey, p)
+}
+
+// PrincipalFrom returns the principal stored by the authentication middleware. ctx is the *gin.Context
+// of the request, as passed to strict handlers.
+func PrincipalFrom(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey).(*Principal)
+	return p, ok && p != nil
+}
+
+// UID returns the uid of the principal of ctx, or "" if the request is not authenticated.
+func UID(ctx context.Context) string {
+	if p, ok := PrincipalFrom(ctx); ok {
+		return p.UID
+	}
+	return ""
+}
+
+func firebasePrincipal(t *fbauth.Token) *Principal {
+	p := &Principal{UID: t.UID, Claims: t.Claims, Roles: rolesFromClaims(t.Claims)}
+	if at, ok := t.Claims["auth_time"].(float64); ok {
+		p.AuthTime = time.Unix(int64(at), 0)
+	}
+	return p
+}
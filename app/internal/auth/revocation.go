@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// revocationCache remembers, per uid, which ID tokens are known not to be revoked, so that
+// VerifyIDTokenAndCheckRevoked (a Firebase round-trip) is only needed on a miss.
+//
+// Firebase revokes by moving the user's tokens_valid_after forward: tokens issued before it are rejected.
+// An entry records validSince, the earliest issue time confirmed acceptable; any token issued at or after it is accepted
+// until the entry expires. Logouts handled by this instance set revoked, making older tokens fail without a round-trip.
+type revocationCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]revocationEntry
+}
+
+type revocationEntry struct {
+	validSince time.Time
+	revoked    bool
+	expires    time.Time
+}
+
+type revocationVerdict int
+
+const (
+	revocationUnknown revocationVerdict = iota
+	revocationValid
+	revocationRevoked
+)
+
+func newRevocationCache(ttl time.Duration) *revocationCache {
+	return &revocationCache{ttl: ttl, entries: map[string]revocationEntry{}}
+}
+
+func (c *revocationCache) check(uid string, issuedAt time.Time) revocationVerdict {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[uid]
+	if !ok || time.Now().After(e.expires) {
+		delete(c.entries, uid)
+		return revocationUnknown
+	}
+	if !issuedAt.Before(e.validSince) {
+		return revocationValid
+	}
+	if e.revoked {
+		return revocationRevoked
+	}
+	return revocationUnknown
+}
+
+// markValid records that a token issued at issuedAt passed a revocation check.
+func (c *revocationCache) markValid(uid string, issuedAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	e, ok := c.entries[uid]
+	if ok && now.Before(e.expires) && !e.revoked && e.validSince.Before(issuedAt) {
+		return
+	}
+	c.entries[uid] = revocationEntry{validSince: issuedAt, expires: now.Add(c.ttl)}
+}
+
+// invalidate records a revocation performed by this instance: tokens issued before now are rejected.
+func (c *revocationCache) invalidate(uid string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Firebase tracks tokens_valid_after in whole seconds.
+	now := time.Now().Truncate(time.Second)
+	c.entries[uid] = revocationEntry{validSince: now, revoked: true, expires: now.Add(c.ttl)}
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+type Role string
+
+const (
+	RoleAdmin Role = "admin"
+)
+
+type Permission string
+
+// Permissions for acting on other users' resources. Acting on one's own resources needs no permission.
+const (
+	PermUsersList    Permission = "users:list"
+	PermUsersDisable Permission = "users:disable"
+	PermTodosReadAny Permission = "todos:read:any"
+	// PermTodoStatusesManage allows adding custom todo statuses.
+	PermTodoStatusesManage Permission = "todo_statuses:manage"
+)
+
+// rolePermissions is the permission matrix.
+var rolePermissions = map[Role][]Permission{
+	RoleAdmin: {PermUsersList, PermUsersDisable, PermTodosReadAny, PermTodoStatusesManage},
+}
+
+// RoleStore resolves roles for principals that don't carry them in token claims (dev tokens).
+type RoleStore interface {
+	RolesByUID(ctx context.Context, uid string) ([]string, error)
+}
+
+// Can reports whether any of the principal's roles grants perm.
+func (p *Principal) Can(perm Permission) bool {
+	for _, r := range p.Roles {
+		if slices.Contains(rolePermissions[r], perm) {
+			return true
+		}
+	}
+	return false
+}
+
+// rolesFromClaims reads Firebase custom claims: `roles: ["admin", ...]` and/or the shorthand `admin: true`.
+func rolesFromClaims(claims map[string]any) []Role {
+	var roles []Role
+	if list, ok := claims["roles"].([]any); ok {
+		for _, v := range list {
+			if s, ok := v.(string); ok && s != "" {
+				roles = append(roles, Role(s))
+			}
+		}
+	}
+	if admin, ok := claims["admin"].(bool); ok && admin && !slices.Contains(roles, RoleAdmin) {
+		roles = append(roles, RoleAdmin)
+	}
+	return roles
+}
+
+func toRoles(in []string) []Role {
+	out := make([]Role, 0, len(in))
+	for _, s := range in {
+		out = append(out, Role(s))
+	}
+	return out
+}
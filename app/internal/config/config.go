@@ -1,25 +1,57 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"gopkg.in/yaml.v3"
 )
 
 type Config struct {
-	Port     string
-	DB       DBConfig
-	Firebase FirebaseConfig
-	Auth     AuthConfig
+	// Env is the deployment environment name (APP_ENV), e.g. "development" or "production".
+	Env  string `yaml:"env"`
+	Port string `yaml:"port"`
+	// TrustedProxies are the proxy CIDRs/IPs whose X-Forwarded-For is believed for the client IP.
+	TrustedProxies []string `yaml:"trusted_proxies"`
+	// ShutdownDrainDelay is how long /readyz fails after SIGTERM before the server stops accepting requests.
+	// It should cover a few readiness probe periods of the load balancer; 0 stops right away.
+	ShutdownDrainDelay time.Duration    `yaml:"shutdown_drain_delay"`
+	DB                 DBConfig         `yaml:"db"`
+	Firebase           FirebaseConfig   `yaml:"firebase"`
+	Auth               AuthConfig       `yaml:"auth"`
+	RateLimit          RateLimitConfig  `yaml:"rate_limit"`
+	Anonymous          AnonymousConfig  `yaml:"anonymous"`
+	Log                LogConfig        `yaml:"log"`
+	Tracing            TracingConfig    `yaml:"tracing"`
+	Validation         ValidationConfig `yaml:"validation"`
+	Docs               DocsConfig       `yaml:"docs"`
 }
 
 type DBConfig struct {
-	DSNEnv   string
-	Host     string
-	Port     string
-	User     string
-	Password string
-	Name     string
+	DSNEnv   string `yaml:"dsn"`
+	Host     string `yaml:"host"`
+	Port     string `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Name     string `yaml:"name"`
+
+	// Pool settings, applied to both the primary and the replica. MaxOpenConns 0 means unlimited.
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	// ConnectTimeout is how long startup keeps retrying while MySQL is not reachable yet.
+	ConnectTimeout time.Duration `yaml:"connect_timeout"`
+
+	// ReplicaDSN optionally routes read-only queries to a replica.
+	ReplicaDSN string `yaml:"replica_dsn"`
+	// ReplicaStickiness is how long a user's reads stay on the primary after they wrote (read-your-writes).
+	ReplicaStickiness time.Duration `yaml:"replica_stickiness"`
 }
 
 func (c DBConfig) DSN() string {
@@ -32,58 +64,299 @@ func (c DBConfig) DSN() string {
 }
 
 type FirebaseConfig struct {
-	APIKey string
+	APIKey string `yaml:"api_key"`
 
 	// Admin SDK credentials
-	ProjectID            string
-	CredentialsFile      string
-	ServiceAccountJSON   string
-	AuthEmulatorHostport string
+	ProjectID            string `yaml:"project_id"`
+	CredentialsFile      string `yaml:"credentials_file"`
+	ServiceAccountJSON   string `yaml:"service_account_json"`
+	AuthEmulatorHostport string `yaml:"auth_emulator_host"`
 }
 
 type AuthConfig struct {
-	Bypass bool
+	// Bypass enables HMAC-signed dev tokens (see `devtoken` subcommand) instead of Firebase ID tokens.
+	// Only allowed when Env is "development".
+	Bypass         bool   `yaml:"bypass"`
+	DevTokenSecret string `yaml:"dev_token_secret"`
+	// RevocationCacheTTL bounds how long a per-user revocation check result is reused.
+	RevocationCacheTTL time.Duration `yaml:"revocation_cache_ttl"`
+}
+
+type RateLimitConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Store is "memory" (per instance) or "mysql" (shared between instances).
+	Store string `yaml:"store"`
+	// Rules overrides ratelimit.DefaultRules, e.g. "POST /login: ip=20/1m, email=5/15m; POST /register: ip=10/1h".
+	Rules string `yaml:"rules"`
+}
+
+type AnonymousConfig struct {
+	// MaxIdle is how long an anonymous account may stay inactive before it is deleted.
+	MaxIdle    time.Duration `yaml:"max_idle"`
+	GCInterval time.Duration `yaml:"gc_interval"`
+}
+
+type LogConfig struct {
+	// Level is debug, info, warn or error.
+	Level string `yaml:"level"`
+	// Format is json or text.
+	Format string `yaml:"format"`
+}
+
+type TracingConfig struct {
+	// Exporter is none, otlp (endpoint from OTEL_EXPORTER_OTLP_ENDPOINT) or stdout.
+	Exporter    string `yaml:"exporter"`
+	ServiceName string `yaml:"service_name"`
+	// SampleRatio is the fraction of new traces that are sampled; incoming traceparent decisions are honored.
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+type ValidationConfig struct {
+	// Responses is off, log (report responses that do not match openapi.yml) or fail (also answer them with a 500).
+	// Meant for development and tests: every response is buffered and validated.
+	Responses string `yaml:"responses"`
+}
+
+type DocsConfig struct {
+	// Enabled serves the spec (/swagger.json, /openapi.yaml) and the Swagger UI page (/docs/).
+	Enabled bool `yaml:"enabled"`
+}
+
+func (c Config) IsDevelopment() bool {
+	return c.Env == "development"
+}
+
+// Errors lists every configuration problem found, so they can all be fixed in one go.
+type Errors []error
+
+func (e Errors) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d configuration problem(s):", len(e))
+	for _, err := range e {
+		b.WriteString("\n  - ")
+		b.WriteString(err.Error())
+	}
+	return b.String()
 }
 
-func Load() Config {
+// Default returns the built-in defaults. There are deliberately no DB credentials or Firebase settings here.
+func Default() Config {
 	return Config{
-		Port: env("PORT", "8080"),
+		Env:                "production",
+		Port:               "8080",
+		ShutdownDrainDelay: 5 * time.Second,
 		DB: DBConfig{
-			DSNEnv:   os.Getenv("DB_DSN"),
-			Host:     env("DB_HOST", "db"),
-			Port:     env("DB_PORT", "3306"),
-			User:     env("DB_USER", "root"),
-			Password: env("DB_PASSWORD", "root"),
-			Name:     env("DB_NAME", "go-gin-webapi"),
-		},
-		Firebase: FirebaseConfig{
-			APIKey:               os.Getenv("FIREBASE_API_KEY"),
-			ProjectID:            os.Getenv("FIREBASE_PROJECT_ID"),
-			CredentialsFile:      os.Getenv("FIREBASE_CREDENTIALS_FILE"),
-			ServiceAccountJSON:   os.Getenv("FIREBASE_SERVICE_ACCOUNT_JSON"),
-			AuthEmulatorHostport: os.Getenv("FIREBASE_AUTH_EMULATOR_HOST"),
+			Host: "db",
+			Port: "3306",
+			Name: "go-gin-webapi",
+
+			MaxOpenConns:      25,
+			MaxIdleConns:      10,
+			ConnMaxLifetime:   5 * time.Minute,
+			ConnectTimeout:    time.Minute,
+			ReplicaStickiness: 5 * time.Second,
 		},
 		Auth: AuthConfig{
-			Bypass: envBool("AUTH_BYPASS", false),
+			RevocationCacheTTL: time.Minute,
+		},
+		RateLimit: RateLimitConfig{
+			Enabled: true,
+			Store:   "memory",
+		},
+		Anonymous: AnonymousConfig{
+			MaxIdle:    30 * 24 * time.Hour,
+			GCInterval: time.Hour,
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "json",
+		},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			ServiceName: "go-gin-webapi",
+			SampleRatio: 1,
+		},
+		Validation: ValidationConfig{
+			Responses: "off",
+		},
+		Docs: DocsConfig{
+			Enabled: true,
 		},
 	}
 }
 
-func env(key, def string) string {
+// Load builds the config from Default, then the YAML file at path (if not empty), then environment variables,
+// and validates the result. Parse and validation problems are reported together as Errors; the config is returned
+// regardless so it can still be printed.
+func Load(path string) (Config, error) {
+	cfg := Default()
+	var errs Errors
+	if path != "" {
+		if err := loadFile(path, &cfg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	e := envLoader{}
+	e.str("APP_ENV", &cfg.Env)
+	e.str("PORT", &cfg.Port)
+	e.list("TRUSTED_PROXIES", &cfg.TrustedProxies)
+	e.duration("SHUTDOWN_DRAIN_DELAY", &cfg.ShutdownDrainDelay)
+	e.str("DB_DSN", &cfg.DB.DSNEnv)
+	e.str("DB_HOST", &cfg.DB.Host)
+	e.str("DB_PORT", &cfg.DB.Port)
+	e.str("DB_USER", &cfg.DB.User)
+	e.str("DB_PASSWORD", &cfg.DB.Password)
+	e.str("DB_NAME", &cfg.DB.Name)
+	e.int("DB_MAX_OPEN_CONNS", &cfg.DB.MaxOpenConns)
+	e.int("DB_MAX_IDLE_CONNS", &cfg.DB.MaxIdleConns)
+	e.duration("DB_CONN_MAX_LIFETIME", &cfg.DB.ConnMaxLifetime)
+	e.duration("DB_CONNECT_TIMEOUT", &cfg.DB.ConnectTimeout)
+	e.str("DB_REPLICA_DSN", &cfg.DB.ReplicaDSN)
+	e.duration("DB_REPLICA_STICKINESS", &cfg.DB.ReplicaStickiness)
+	e.str("FIREBASE_API_KEY", &cfg.Firebase.APIKey)
+	e.str("FIREBASE_PROJECT_ID", &cfg.Firebase.ProjectID)
+	e.str("FIREBASE_CREDENTIALS_FILE", &cfg.Firebase.CredentialsFile)
+	e.str("FIREBASE_SERVICE_ACCOUNT_JSON", &cfg.Firebase.ServiceAccountJSON)
+	e.str("FIREBASE_AUTH_EMULATOR_HOST", &cfg.Firebase.AuthEmulatorHostport)
+	e.bool("AUTH_BYPASS", &cfg.Auth.Bypass)
+	e.str("AUTH_DEV_TOKEN_SECRET", &cfg.Auth.DevTokenSecret)
+	e.duration("AUTH_REVOCATION_CACHE_TTL", &cfg.Auth.RevocationCacheTTL)
+	e.bool("RATE_LIMIT_ENABLED", &cfg.RateLimit.Enabled)
+	e.str("RATE_LIMIT_STORE", &cfg.RateLimit.Store)
+	e.str("RATE_LIMIT_RULES", &cfg.RateLimit.Rules)
+	e.duration("ANONYMOUS_MAX_IDLE", &cfg.Anonymous.MaxIdle)
+	e.duration("ANONYMOUS_GC_INTERVAL", &cfg.Anonymous.GCInterval)
+	e.str("LOG_LEVEL", &cfg.Log.Level)
+	e.str("LOG_FORMAT", &cfg.Log.Format)
+	e.str("TRACING_EXPORTER", &cfg.Tracing.Exporter)
+	e.str("OTEL_SERVICE_NAME", &cfg.Tracing.ServiceName)
+	e.float("TRACING_SAMPLE_RATIO", &cfg.Tracing.SampleRatio)
+	e.str("VALIDATE_RESPONSES", &cfg.Validation.Responses)
+	e.bool("DOCS_ENABLED", &cfg.Docs.Enabled)
+	errs = append(errs, e.errs...)
+
+	var verrs Errors
+	if errors.As(cfg.Validate(), &verrs) {
+		errs = append(errs, verrs...)
+	}
+	if len(errs) > 0 {
+		return cfg, errs
+	}
+	return cfg, nil
+}
+
+func loadFile(path string, cfg *Config) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+	defer f.Close()
+
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Redacted returns a copy of c with secrets masked, for display.
+func (c Config) Redacted() Config {
+	const mask = "[REDACTED]"
+	mask1 := func(s *string) {
+		if *s != "" {
+			*s = mask
+		}
+	}
+	mask1(&c.DB.Password)
+	mask1(&c.Firebase.APIKey)
+	mask1(&c.Firebase.ServiceAccountJSON)
+	mask1(&c.Auth.DevTokenSecret)
+	maskDSN := func(s *string) {
+		if *s == "" {
+			return
+		}
+		if dsn, err := mysql.ParseDSN(*s); err == nil {
+			mask1(&dsn.Passwd)
+			*s = dsn.FormatDSN()
+		} else {
+			*s = mask
+		}
+	}
+	maskDSN(&c.DB.DSNEnv)
+	maskDSN(&c.DB.ReplicaDSN)
+	c.TrustedProxies = append([]string(nil), c.TrustedProxies...)
+	return c
+}
+
+// envLoader applies set environment variables and records values that don't parse, instead of ignoring them.
+type envLoader struct {
+	errs Errors
+}
+
+func (e *envLoader) str(key string, dst *string) {
 	if v := os.Getenv(key); v != "" {
-		return v
+		*dst = v
 	}
-	return def
 }
 
-func envBool(key string, def bool) bool {
+func (e *envLoader) bool(key string, dst *bool) {
 	if v := os.Getenv(key); v != "" {
 		b, err := strconv.ParseBool(v)
-		if err == nil {
-			return b
+		if err != nil {
+			e.errs = append(e.errs, fmt.Errorf("%s=%q: want true or false", key, v))
+			return
+		}
+		*dst = b
+	}
+}
+
+func (e *envLoader) int(key string, dst *int) {
+	if v := os.Getenv(key); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			e.errs = append(e.errs, fmt.Errorf("%s=%q: want an integer", key, v))
+			return
+		}
+		*dst = n
+	}
+}
+
+func (e *envLoader) duration(key string, dst *time.Duration) {
+	if v := os.Getenv(key); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			e.errs = append(e.errs, fmt.Errorf("%s=%q: want a duration such as 30s or 1h", key, v))
+			return
+		}
+		*dst = d
+	}
+}
+
+func (e *envLoader) float(key string, dst *float64) {
+	if v := os.Getenv(key); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			e.errs = append(e.errs, fmt.Errorf("%s=%q: want a number", key, v))
+			return
+		}
+		*dst = f
+	}
+}
+
+func (e *envLoader) list(key string, dst *[]string) {
+	v := os.Getenv(key)
+	if v == "" {
+		return
+	}
+	var out []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
 		}
 	}
-	return def
+	*dst = out
 }
 
 
@@ -0,0 +1,163 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+
+	"go-gin-webapi/internal/ratelimit"
+)
+
+// Validate checks the whole config and returns every problem as Errors, or nil.
+func (c Config) Validate() error {
+	var errs Errors
+	add := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if c.Env == "" {
+		add("APP_ENV is empty")
+	}
+	if p, err := strconv.Atoi(c.Port); err != nil || p < 1 || p > 65535 {
+		add("PORT=%q: want a port number between 1 and 65535", c.Port)
+	}
+	for _, p := range c.TrustedProxies {
+		if net.ParseIP(p) == nil {
+			if _, _, err := net.ParseCIDR(p); err != nil {
+				add("TRUSTED_PROXIES: %q is neither an IP nor a CIDR", p)
+			}
+		}
+	}
+	if c.ShutdownDrainDelay < 0 {
+		add("SHUTDOWN_DRAIN_DELAY must not be negative")
+	}
+
+	if c.DB.DSNEnv != "" {
+		if err := checkDSN(c.DB.DSNEnv); err != nil {
+			add("DB_DSN: %v", err)
+		}
+	} else {
+		if c.DB.Host == "" {
+			add("DB_HOST is required (or set DB_DSN)")
+		}
+		if p, err := strconv.Atoi(c.DB.Port); err != nil || p < 1 || p > 65535 {
+			add("DB_PORT=%q: want a port number between 1 and 65535", c.DB.Port)
+		}
+		if c.DB.User == "" {
+			add("DB_USER is required (or set DB_DSN)")
+		}
+		if c.DB.Name == "" {
+			add("DB_NAME is required (or set DB_DSN)")
+		}
+	}
+	if c.DB.ReplicaDSN != "" {
+		if err := checkDSN(c.DB.ReplicaDSN); err != nil {
+			add("DB_REPLICA_DSN: %v", err)
+		}
+	}
+	if c.DB.MaxOpenConns < 0 || c.DB.MaxIdleConns < 0 {
+		add("DB_MAX_OPEN_CONNS and DB_MAX_IDLE_CONNS must not be negative")
+	} else if c.DB.MaxOpenConns > 0 && c.DB.MaxIdleConns > c.DB.MaxOpenConns {
+		add("DB_MAX_IDLE_CONNS (%d) must not exceed DB_MAX_OPEN_CONNS (%d)", c.DB.MaxIdleConns, c.DB.MaxOpenConns)
+	}
+	if c.DB.ConnMaxLifetime < 0 {
+		add("DB_CONN_MAX_LIFETIME must not be negative")
+	}
+	if c.DB.ConnectTimeout <= 0 {
+		add("DB_CONNECT_TIMEOUT must be positive")
+	}
+	if c.DB.ReplicaStickiness < 0 {
+		add("DB_REPLICA_STICKINESS must not be negative")
+	}
+
+	if c.Auth.Bypass {
+		if !c.IsDevelopment() {
+			add("AUTH_BYPASS=true is only allowed with APP_ENV=development (got %q)", c.Env)
+		}
+		if c.Auth.DevTokenSecret == "" {
+			add("AUTH_BYPASS=true requires AUTH_DEV_TOKEN_SECRET")
+		}
+	} else {
+		// Without bypass every bearer token is a Firebase ID token, and register/login go through IdentityToolkit.
+		if c.Firebase.APIKey == "" {
+			add("FIREBASE_API_KEY is required unless AUTH_BYPASS=true")
+		}
+		if c.Firebase.ProjectID == "" && c.Firebase.CredentialsFile == "" && c.Firebase.ServiceAccountJSON == "" {
+			add("FIREBASE_PROJECT_ID or service account credentials are required unless AUTH_BYPASS=true")
+		}
+	}
+	if c.Firebase.CredentialsFile != "" {
+		if _, err := os.Stat(c.Firebase.CredentialsFile); err != nil {
+			add("FIREBASE_CREDENTIALS_FILE: %v", err)
+		}
+	}
+	if c.Firebase.ServiceAccountJSON != "" && !json.Valid([]byte(c.Firebase.ServiceAccountJSON)) {
+		add("FIREBASE_SERVICE_ACCOUNT_JSON is not valid JSON")
+	}
+	if c.Auth.RevocationCacheTTL < 0 {
+		add("AUTH_REVOCATION_CACHE_TTL must not be negative")
+	}
+
+	if s := c.RateLimit.Store; s != "memory" && s != "mysql" {
+		add("RATE_LIMIT_STORE=%q: want memory or mysql", s)
+	}
+	if _, err := ratelimit.ParseRules(c.RateLimit.Rules, ratelimit.DefaultRules); err != nil {
+		add("RATE_LIMIT_RULES: %v", err)
+	}
+	if c.Anonymous.MaxIdle <= 0 {
+		add("ANONYMOUS_MAX_IDLE must be positive")
+	}
+	if c.Anonymous.GCInterval <= 0 {
+		add("ANONYMOUS_GC_INTERVAL must be positive")
+	}
+
+	switch strings.ToLower(c.Log.Level) {
+	case "debug", "info", "warn", "error":
+	default:
+		add("LOG_LEVEL=%q: want debug, info, warn or error", c.Log.Level)
+	}
+	switch strings.ToLower(c.Log.Format) {
+	case "json", "text":
+	default:
+		add("LOG_FORMAT=%q: want json or text", c.Log.Format)
+	}
+
+	switch strings.ToLower(c.Tracing.Exporter) {
+	case "none", "otlp", "stdout":
+	default:
+		add("TRACING_EXPORTER=%q: want none, otlp or stdout", c.Tracing.Exporter)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		add("TRACING_SAMPLE_RATIO=%v: want a value between 0 and 1", c.Tracing.SampleRatio)
+	}
+
+	switch strings.ToLower(c.Validation.Responses) {
+	case "off", "log", "fail":
+	default:
+		add("VALIDATE_RESPONSES=%q: want off, log or fail", c.Validation.Responses)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func checkDSN(s string) error {
+	dsn, err := mysql.ParseDSN(s)
+	switch {
+	case err != nil:
+		return err
+	case !dsn.ParseTime:
+		return errors.New("parseTime=true is required (DATETIME columns are scanned into time.Time)")
+	case dsn.DBName == "":
+		return errors.New("database name is missing")
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"go-gin-webapi/internal/auth"
+	"go-gin-webapi/schemas"
+)
+
+// activityInterval is how often an active user's last_active_at is written at most (per instance).
+const activityInterval = 5 * time.Minute
+
+// TrackActivity records when authenticated users were last active, so idle anonymous accounts can be collected.
+// Must run after auth.Middleware.
+func (a *API) TrackActivity() schemas.StrictMiddlewareFunc {
+	var seen sync.Map // uid -> time.Time
+	return func(next schemas.StrictHandlerFunc, operationID string) schemas.StrictHandlerFunc {
+		return func(c *gin.Context, request any) (any, error) {
+			if p, ok := auth.PrincipalFrom(c); ok {
+				a.touch(c, &seen, p.UID)
+			}
+			return next(c, request)
+		}
+	}
+}
+
+func (a *API) touch(ctx context.Context, seen *sync.Map, uid string) {
+	now := time.Now()
+	if last, ok := seen.Load(uid); ok && now.Sub(last.(time.Time)) < activityInterval {
+		return
+	}
+	seen.Store(uid, now)
+	if err := a.repos.Users.TouchActive(ctx, uid); err != nil {
+		slog.WarnContext(ctx, "track activity", "err", err)
+	}
+}
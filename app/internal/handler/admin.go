@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+
+	fbauth "firebase.google.com/go/v4/auth"
+
+	"go-gin-webapi/internal/auth"
+	"go-gin-webapi/internal/repo"
+	"go-gin-webapi/schemas"
+)
+
+func (a *API) GetAdminUsers(ctx context.Context, request schemas.GetAdminUsersRequestObject) (schemas.GetAdminUsersResponseObject, error) {
+	if !a.requirePermission(ctx, auth.PermUsersList) {
+		return schemas.GetAdminUsers403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	params := request.Params
+	limit, offset := 50, 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	users, err := a.repos.Users.List(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	out := make(schemas.AdminUserListResponse, 0, len(users))
+	for _, u := range users {
+		out = append(out, toAdminUser(u))
+	}
+	return schemas.GetAdminUsers200JSONResponse(out), nil
+}
+
+func (a *API) GetAdminUsersUserIdTodos(ctx context.Context, request schemas.GetAdminUsersUserIdTodosRequestObject) (schemas.GetAdminUsersUserIdTodosResponseObject, error) {
+	if !a.requirePermission(ctx, auth.PermTodosReadAny) {
+		return schemas.GetAdminUsersUserIdTodos403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	if _, err := a.repos.Users.GetByUID(ctx, string(request.UserId)); err != nil {
+		if err == sql.ErrNoRows {
+			return schemas.GetAdminUsersUserIdTodos404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
+		}
+		return nil, err
+	}
+
+	todos, err := a.repos.Todos.ListByOwner(ctx, string(request.UserId))
+	if err != nil {
+		return nil, err
+	}
+	out, err := a.toTodoListResponse(ctx, todos)
+	if err != nil {
+		return nil, err
+	}
+	return schemas.GetAdminUsersUserIdTodos200JSONResponse(out), nil
+}
+
+func (a *API) PostAdminUsersUserIdDisable(ctx context.Context, request schemas.PostAdminUsersUserIdDisableRequestObject) (schemas.PostAdminUsersUserIdDisableResponseObject, error) {
+	if !a.requirePermission(ctx, auth.PermUsersDisable) {
+		return schemas.PostAdminUsersUserIdDisable403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	u, err := a.setUserDisabled(ctx, string(request.UserId), true)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return schemas.PostAdminUsersUserIdDisable404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
+		}
+		return nil, err
+	}
+	return schemas.PostAdminUsersUserIdDisable200JSONResponse(toAdminUser(u)), nil
+}
+
+func (a *API) PostAdminUsersUserIdEnable(ctx context.Context, request schemas.PostAdminUsersUserIdEnableRequestObject) (schemas.PostAdminUsersUserIdEnableResponseObject, error) {
+	if !a.requirePermission(ctx, auth.PermUsersDisable) {
+		return schemas.PostAdminUsersUserIdEnable403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	u, err := a.setUserDisabled(ctx, string(request.UserId), false)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return schemas.PostAdminUsersUserIdEnable404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
+		}
+		return nil, err
+	}
+	return schemas.PostAdminUsersUserIdEnable200JSONResponse(toAdminUser(u)), nil
+}
+
+// setUserDisabled flips the user in Firebase, when Firebase Admin is configured, and in the db. Without
+// it (dev tokens) only users.disabled is set, which is what access tokens are checked against. It returns
+// sql.ErrNoRows for an unknown user.
+func (a *API) setUserDisabled(ctx context.Context, uid string, disabled bool) (repo.User, error) {
+	if _, err := a.repos.Users.GetByUID(ctx, uid); err != nil {
+		return repo.User{}, err
+	}
+
+	if a.fbAdmin != nil && a.fbAdmin.Auth != nil {
+		if _, err := a.fbAdmin.Auth.UpdateUser(ctx, uid, (&fbauth.UserToUpdate{}).Disabled(disabled)); err != nil {
+			if fbauth.IsUserNotFound(err) {
+				return repo.User{}, sql.ErrNoRows
+			}
+			return repo.User{}, err
+		}
+	}
+	if disabled {
+		// Disabling blocks new sign-ins only; also cut off existing sessions.
+		if err := a.verifier.RevokeSessions(ctx, uid); err != nil {
+			return repo.User{}, err
+		}
+	}
+	return a.repos.Users.SetDisabled(ctx, uid, disabled)
+}
+
+func toAdminUser(u repo.User) schemas.AdminUser {
+	uid := u.UID
+	nickname := u.Nickname
+	disabled := u.Disabled
+	return schemas.AdminUser{
+		Uid:      &uid,
+		Nickname: &nickname,
+		Email:    emailPtr(u.Email),
+		Disabled: &disabled,
+	}
+}
@@ -2,26 +2,28 @@ package handler
 
 import (
 	"go-gin-webapi/internal/auth"
-	"go-gin-webapi/internal/config"
 	"go-gin-webapi/internal/repo"
 	"go-gin-webapi/schemas"
 )
 
+// API implements the strict handlers. Authentication happens in auth.Middleware before any handler runs.
 type API struct {
 	repos    *repo.Repos
 	idtk     *auth.IdentityToolkitClient
 	fbAdmin  *auth.FirebaseAdmin
 	verifier *auth.Verifier
+	statuses *todoStatusCatalog
 }
 
-func NewAPI(repos *repo.Repos, idtk *auth.IdentityToolkitClient, fbAdmin *auth.FirebaseAdmin, fbAdminErr error, authCfg config.AuthConfig) *API {
+func NewAPI(repos *repo.Repos, idtk *auth.IdentityToolkitClient, fbAdmin *auth.FirebaseAdmin, verifier *auth.Verifier) *API {
 	return &API{
 		repos:    repos,
 		idtk:     idtk,
 		fbAdmin:  fbAdmin,
-		verifier: auth.NewVerifier(fbAdmin, fbAdminErr, authCfg),
+		verifier: verifier,
+		statuses: &todoStatusCatalog{repo: repos.Statuses},
 	}
 }
 
-var _ schemas.ServerInterface = (*API)(nil)
+var _ schemas.StrictServerInterface = (*API)(nil)
 
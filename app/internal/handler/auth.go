@@ -1,111 +1,248 @@
 package handler
 
 import (
+	"context"
+	"crypto/rand"
 	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
 	"strings"
 
-	"github.com/gin-gonic/gin"
+	fbauth "firebase.google.com/go/v4/auth"
 
+	"go-gin-webapi/internal/auth"
+	"go-gin-webapi/internal/i18n"
+	"go-gin-webapi/internal/problem"
 	"go-gin-webapi/internal/repo"
 	"go-gin-webapi/schemas"
 )
 
-func (a *API) PostRegister(c *gin.Context) {
-	var req schemas.RegisterUserRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		badRequest(c, "invalid json")
-		return
-	}
-	if req.Email == nil || strings.TrimSpace(string(*req.Email)) == "" || req.Password == nil || strings.TrimSpace(*req.Password) == "" || req.Nickname == nil || strings.TrimSpace(*req.Nickname) == "" {
-		badRequest(c, "email/password/nickname are required")
-		return
-	}
-	if runeLen(strings.TrimSpace(*req.Nickname)) > 20 {
-		badRequest(c, "nickname must be <= 20 chars")
-		return
+func (a *API) PostRegister(ctx context.Context, request schemas.PostRegisterRequestObject) (schemas.PostRegisterResponseObject, error) {
+	req := *request.Body
+	// Presence and lengths are checked by the spec; reject what is only whitespace.
+	if err := notBlank("email", string(req.Email), "password", req.Password, "nickname", req.Nickname); err != nil {
+		return schemas.PostRegister400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, err)}, nil
 	}
 
-	uid, idToken, refreshToken, err := a.idtk.SignUp(c.Request.Context(), string(*req.Email), *req.Password)
+	uid, idToken, refreshToken, err := a.idtk.SignUp(ctx, string(req.Email), req.Password)
 	if err != nil {
-		// spec: 400/500 only
-		badRequest(c, err.Error())
-		return
+		if p, ok := identityRejected(ctx, err); ok {
+			return schemas.PostRegister400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: p}, nil
+		}
+		return nil, err
 	}
 
-	if err := a.repos.Users.Create(c.Request.Context(), repo.User{
+	if err := a.repos.Users.Create(ctx, repo.User{
 		UID:      uid,
-		Nickname: *req.Nickname,
-		Email:    string(*req.Email),
+		Nickname: req.Nickname,
+		Email:    string(req.Email),
 	}); err != nil {
 		if isMySQLDuplicate(err) {
-			badRequest(c, "email already exists")
-			return
+			return schemas.PostRegister400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, problem.EmailTaken, "")}, nil
 		}
-		internalErr(c, err)
-		return
+		return nil, err
+	}
+
+	return schemas.PostRegister201JSONResponse{
+		Uid:          strPtr(uid),
+		AccessToken:  strPtr(idToken),
+		RefreshToken: strPtr(refreshToken),
+	}, nil
+}
+
+func (a *API) PostRegisterAnonymous(ctx context.Context, request schemas.PostRegisterAnonymousRequestObject) (schemas.PostRegisterAnonymousResponseObject, error) {
+	uid, idToken, refreshToken, err := a.idtk.SignUpAnonymous(ctx)
+	if err != nil {
+		if p, ok := identityRejected(ctx, err); ok {
+			return schemas.PostRegisterAnonymous400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: p}, nil
+		}
+		return nil, err
+	}
+
+	nickname, err := guestNickname()
+	if err != nil {
+		return nil, err
+	}
+	if err := a.repos.Users.Create(ctx, repo.User{
+		UID:         uid,
+		Nickname:    nickname,
+		IsAnonymous: true,
+	}); err != nil {
+		return nil, err
 	}
 
-	c.JSON(201, schemas.RegisterUserResponse{
+	return schemas.PostRegisterAnonymous201JSONResponse{
 		Uid:          strPtr(uid),
+		Nickname:     strPtr(nickname),
 		AccessToken:  strPtr(idToken),
 		RefreshToken: strPtr(refreshToken),
-	})
+	}, nil
 }
 
-func (a *API) PostLogin(c *gin.Context) {
-	var req schemas.LoginUserRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		badRequest(c, "invalid json")
-		return
+// PostUsersUserIdUpgrade links email/password to an anonymous user. The uid is kept, so are its todos.
+func (a *API) PostUsersUserIdUpgrade(ctx context.Context, request schemas.PostUsersUserIdUpgradeRequestObject) (schemas.PostUsersUserIdUpgradeResponseObject, error) {
+	if !a.requireSelf(ctx, string(request.UserId), auth.ScopeAll) {
+		return schemas.PostUsersUserIdUpgrade403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
 	}
-	if req.Email == nil || strings.TrimSpace(string(*req.Email)) == "" || req.Password == nil || strings.TrimSpace(*req.Password) == "" {
-		badRequest(c, "email/password are required")
-		return
+	req := *request.Body
+	fields := []string{"email", string(req.Email), "password", req.Password}
+	if req.Nickname != nil {
+		n := strings.TrimSpace(*req.Nickname)
+		fields = append(fields, "nickname", n)
+		req.Nickname = &n
 	}
+	if err := notBlank(fields...); err != nil {
+		return schemas.PostUsersUserIdUpgrade400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, err)}, nil
+	}
+	email := string(req.Email)
 
-	uid, idToken, refreshToken, err := a.idtk.SignInWithPassword(c.Request.Context(), string(*req.Email), *req.Password)
+	u, err := a.repos.Users.GetByUID(ctx, string(request.UserId))
 	if err != nil {
-		// spec: 400/500 only
-		badRequest(c, err.Error())
-		return
+		if err == sql.ErrNoRows {
+			return schemas.PostUsersUserIdUpgrade404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
+		}
+		return nil, err
+	}
+	if !u.IsAnonymous {
+		return schemas.PostUsersUserIdUpgrade400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, problem.AlreadyRegistered, "")}, nil
+	}
+	if a.fbAdmin == nil || a.fbAdmin.Auth == nil {
+		return nil, errors.New("firebase admin not configured")
+	}
+
+	if _, err := a.fbAdmin.Auth.UpdateUser(ctx, u.UID, (&fbauth.UserToUpdate{}).Email(email).Password(req.Password)); err != nil {
+		if fbauth.IsEmailAlreadyExists(err) {
+			return schemas.PostUsersUserIdUpgrade400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, problem.EmailTaken, "")}, nil
+		}
+		return nil, err
+	}
+	// Fresh tokens carry the email/password sign-in provider.
+	_, idToken, refreshToken, err := a.idtk.SignInWithPassword(ctx, email, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := a.repos.Users.Upgrade(ctx, u.UID, email, req.Nickname); err != nil {
+		if isMySQLDuplicate(err) {
+			return schemas.PostUsersUserIdUpgrade400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, problem.EmailTaken, "")}, nil
+		}
+		return nil, err
 	}
 
-	if _, err := a.repos.Users.GetByUID(c.Request.Context(), uid); err != nil {
+	return schemas.PostUsersUserIdUpgrade200JSONResponse{
+		Uid:          strPtr(u.UID),
+		AccessToken:  strPtr(idToken),
+		RefreshToken: strPtr(refreshToken),
+	}, nil
+}
+
+func (a *API) PostLogin(ctx context.Context, request schemas.PostLoginRequestObject) (schemas.PostLoginResponseObject, error) {
+	req := *request.Body
+	if err := notBlank("email", string(req.Email), "password", req.Password); err != nil {
+		return schemas.PostLogin400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, err)}, nil
+	}
+
+	uid, idToken, refreshToken, err := a.idtk.SignInWithPassword(ctx, string(req.Email), req.Password)
+	if err != nil {
+		if p, ok := identityRejected(ctx, err); ok {
+			return schemas.PostLogin400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: p}, nil
+		}
+		return nil, err
+	}
+
+	if _, err := a.repos.Users.GetByUID(ctx, uid); err != nil {
 		if err == sql.ErrNoRows {
-			badRequest(c, "user not found in db (register first)")
-			return
+			return schemas.PostLogin400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, problem.NotRegistered, "detail.register_first")}, nil
 		}
-		internalErr(c, err)
-		return
+		return nil, err
 	}
 
-	c.JSON(201, schemas.LoginUserResponse{
+	return schemas.PostLogin201JSONResponse{
 		Uid:          strPtr(uid),
 		AccessToken:  strPtr(idToken),
 		RefreshToken: strPtr(refreshToken),
-	})
+	}, nil
 }
 
-func (a *API) PostLogout(c *gin.Context) {
-	var req schemas.LogoutUserRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		badRequest(c, "invalid json")
-		return
+// PostToken trades a refresh token for new tokens. A token Firebase no longer honours (expired, revoked by /logout,
+// disabled user) is a 401 so clients know to log in again.
+func (a *API) PostToken(ctx context.Context, request schemas.PostTokenRequestObject) (schemas.PostTokenResponseObject, error) {
+	req := *request.Body
+	if err := notBlank("refresh_token", req.RefreshToken); err != nil {
+		return schemas.PostToken400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, err)}, nil
 	}
-	if req.UserId == nil || strings.TrimSpace(*req.UserId) == "" {
-		badRequest(c, "user_id is required")
-		return
+
+	uid, idToken, refreshToken, expiresIn, err := a.idtk.Refresh(ctx, req.RefreshToken)
+	if err != nil {
+		var ie *auth.IdentityToolkitError
+		if errors.As(err, &ie) {
+			switch ie.Code {
+			case "TOKEN_EXPIRED", "INVALID_REFRESH_TOKEN", "USER_DISABLED", "USER_NOT_FOUND":
+				return schemas.PostToken401ApplicationProblemPlusJSONResponse{UnauthorizedApplicationProblemPlusJSONResponse: schemas.UnauthorizedApplicationProblemPlusJSONResponse(
+					problem.New(ctx, http.StatusUnauthorized, problem.Unauthorized, i18n.T(ctx, "detail.refresh_token_rejected")))}, nil
+			}
+		}
+		if p, ok := identityRejected(ctx, err); ok {
+			return schemas.PostToken400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: p}, nil
+		}
+		return nil, err
 	}
-	if !a.requireSelf(c, *req.UserId) {
-		return
+
+	secs := int(expiresIn.Seconds())
+	return schemas.PostToken200JSONResponse{
+		Uid:          strPtr(uid),
+		AccessToken:  strPtr(idToken),
+		RefreshToken: strPtr(refreshToken),
+		ExpiresIn:    &secs,
+	}, nil
+}
+
+func (a *API) PostLogout(ctx context.Context, request schemas.PostLogoutRequestObject) (schemas.PostLogoutResponseObject, error) {
+	req := *request.Body
+	if err := notBlank("user_id", req.UserId); err != nil {
+		return schemas.PostLogout400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, err)}, nil
+	}
+	if !a.requireSelf(ctx, req.UserId, auth.ScopeAll) {
+		return schemas.PostLogout403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+
+	if err := a.verifier.RevokeSessions(ctx, req.UserId); err != nil {
+		return nil, err
 	}
 
-	if a.fbAdmin != nil && a.fbAdmin.Auth != nil {
-		_ = a.fbAdmin.Auth.RevokeRefreshTokens(c.Request.Context(), *req.UserId)
+	return schemas.PostLogout201JSONResponse{Message: strPtr("logged out")}, nil
+}
+
+// identityRejected answers a sign-up or sign-in that IdentityToolkit refused; false for other errors (500).
+func identityRejected(ctx context.Context, err error) (schemas.BadRequestApplicationProblemPlusJSONResponse, bool) {
+	var ie *auth.IdentityToolkitError
+	if !errors.As(err, &ie) {
+		return schemas.BadRequestApplicationProblemPlusJSONResponse{}, false
+	}
+	switch ie.Code {
+	case "EMAIL_EXISTS":
+		return badRequest(ctx, problem.EmailTaken, ""), true
+	case "EMAIL_NOT_FOUND", "INVALID_PASSWORD", "INVALID_LOGIN_CREDENTIALS":
+		return badRequest(ctx, problem.InvalidCredentials, ""), true
+	case "INVALID_EMAIL":
+		return invalidField(ctx, "email", "format", "email"), true
+	case "WEAK_PASSWORD":
+		return invalidField(ctx, "password", "strength", nil), true
 	}
+	// Firebase's message stays in the log.
+	slog.WarnContext(ctx, "identitytoolkit rejected the request", "err", err)
+	return badRequest(ctx, problem.IdentityRejected, ""), true
+}
 
-	msg := "logged out"
-	c.JSON(201, schemas.LogoutUserResponse{Message: &msg})
+// guestNickname generates a nickname for anonymous users, e.g. ゲスト042137.
+func guestNickname() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("ゲスト%06d", n.Int64()), nil
 }
 
 
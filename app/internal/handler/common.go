@@ -1,59 +1,213 @@
 package handler
 
 import (
+	"context"
 	"errors"
+	"log/slog"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	mysqlDriver "github.com/go-sql-driver/mysql"
 
+	"go-gin-webapi/internal/apispec"
 	"go-gin-webapi/internal/auth"
+	"go-gin-webapi/internal/i18n"
+	"go-gin-webapi/internal/metrics"
+	"go-gin-webapi/internal/problem"
+	"go-gin-webapi/internal/ratelimit"
 	"go-gin-webapi/schemas"
 )
 
 func strPtr(s string) *string { return &s }
 
-func badRequest(c *gin.Context, msg string) {
-	c.JSON(http.StatusBadRequest, schemas.BadRequestJSONResponse{Error: &msg})
+// fieldError is a request value rejected by a check the spec cannot express (blank once trimmed,
+// an unknown status label, ...). It is answered like the spec's own violations: validation_failed.
+// Its message is the rule's in the i18n catalogs; param fills it in (see i18n.Field).
+type fieldError struct {
+	field, rule string
+	param       any
 }
 
-func unauthorized(c *gin.Context) {
-	msg := "unauthorized"
-	c.JSON(http.StatusUnauthorized, schemas.UnauthorizedJSONResponse{Error: &msg})
+func (e *fieldError) Error() string { return e.field + ": " + e.message(context.Background()) }
+
+func (e *fieldError) message(ctx context.Context) string {
+	if msg, ok := i18n.Field(ctx, e.rule, e.param); ok {
+		return msg
+	}
+	return e.rule
+}
+
+// notBlank checks name, value pairs for values that are only whitespace, which minLength lets through.
+func notBlank(pairs ...string) error {
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if strings.TrimSpace(pairs[i+1]) == "" {
+			return &fieldError{field: pairs[i], rule: "not_blank"}
+		}
+	}
+	return nil
+}
+
+// badRequest is the problem for code; detail is a message key of the i18n catalogs, "" for none.
+func badRequest(ctx context.Context, code, detail string, args ...any) schemas.BadRequestApplicationProblemPlusJSONResponse {
+	return schemas.BadRequestApplicationProblemPlusJSONResponse(problem.New(ctx, http.StatusBadRequest, code, localize(ctx, detail, args...)))
+}
+
+func localize(ctx context.Context, key string, args ...any) string {
+	if key == "" {
+		return ""
+	}
+	return i18n.T(ctx, key, args...)
+}
+
+// errorMessage renders an error meant for the client (a fieldError, an i18n.Message, ...) in the request's language.
+func errorMessage(ctx context.Context, err error) string {
+	var (
+		fe *fieldError
+		te *transitionError
+		m  i18n.Message
+	)
+	switch {
+	case errors.As(err, &fe):
+		return fe.field + ": " + fe.message(ctx)
+	case errors.As(err, &te):
+		return te.detail.In(ctx)
+	case errors.As(err, &m):
+		return m.In(ctx)
+	}
+	return err.Error()
+}
+
+// invalid answers the client error of a validation helper (newTodo, todoUpdate, ...), normally a fieldError.
+func invalid(ctx context.Context, err error) schemas.BadRequestApplicationProblemPlusJSONResponse {
+	var fe *fieldError
+	if !errors.As(err, &fe) {
+		return schemas.BadRequestApplicationProblemPlusJSONResponse(problem.New(ctx, http.StatusBadRequest, problem.ValidationFailed, errorMessage(ctx, err)))
+	}
+	return schemas.BadRequestApplicationProblemPlusJSONResponse(problem.Invalid(ctx, schemas.FieldError{Field: fe.field, Rule: fe.rule, Message: fe.message(ctx)}))
+}
+
+func invalidField(ctx context.Context, field, rule string, param any) schemas.BadRequestApplicationProblemPlusJSONResponse {
+	return invalid(ctx, &fieldError{field: field, rule: rule, param: param})
+}
+
+func unauthorized(ctx context.Context) schemas.UnauthorizedApplicationProblemPlusJSONResponse {
+	return schemas.UnauthorizedApplicationProblemPlusJSONResponse(problem.New(ctx, http.StatusUnauthorized, problem.Unauthorized, ""))
 }
 
-func forbidden(c *gin.Context) {
-	msg := "forbidden"
-	c.JSON(http.StatusForbidden, schemas.ForbiddenJSONResponse{Error: &msg})
+func forbidden(ctx context.Context) schemas.ForbiddenApplicationProblemPlusJSONResponse {
+	return schemas.ForbiddenApplicationProblemPlusJSONResponse(problem.New(ctx, http.StatusForbidden, problem.Forbidden, ""))
 }
 
-func notFound(c *gin.Context) {
-	msg := "not found"
-	c.JSON(http.StatusNotFound, schemas.NotFoundJSONResponse{Error: &msg})
+func notFound(ctx context.Context) schemas.NotFoundApplicationProblemPlusJSONResponse {
+	return schemas.NotFoundApplicationProblemPlusJSONResponse(problem.New(ctx, http.StatusNotFound, problem.NotFound, ""))
 }
 
-func internalErr(c *gin.Context, err error) {
-	msg := err.Error()
-	c.JSON(http.StatusInternalServerError, schemas.InternalServerErrorJSONResponse{Error: &msg})
+// NotFound answers requests that matched a route but no operation (see apispec.Index.LiteralColons).
+func NotFound(c *gin.Context) {
+	problem.Write(c, problem.New(c, http.StatusNotFound, problem.NotFound, ""))
 }
 
-func (a *API) requireSelf(c *gin.Context, userID string) bool {
-	uid, err := a.verifier.RequireUID(c)
-	if err != nil {
-		// Token missing/invalid -> 401.
-		// Verifier misconfigured (e.g. Firebase Admin not configured) -> 500 to aid debugging.
-		if errors.Is(err, auth.ErrUnauthorized) {
-			unauthorized(c)
-		} else {
-			internalErr(c, err)
+// InvalidRequest answers a request that does not match the spec (see apispec.Index.ValidateRequests).
+func InvalidRequest(c *gin.Context, err *apispec.RequestError) {
+	switch {
+	case err.Status == http.StatusUnauthorized:
+		metrics.RecordAuth("authenticate", metrics.AuthUnauthorized)
+		problem.Write(c, problem.New(c, http.StatusUnauthorized, problem.Unauthorized, ""))
+	case err.Status == http.StatusUnsupportedMediaType:
+		problem.Write(c, problem.New(c, http.StatusUnsupportedMediaType, problem.UnsupportedMediaType,
+			i18n.T(c, "detail.content_type", strings.Join(err.MediaTypes, ", "))))
+	case len(err.Fields) > 0:
+		fields := make([]schemas.FieldError, 0, len(err.Fields))
+		for _, f := range err.Fields {
+			// kin-openapi's own message is kept for rules the catalogs do not know.
+			msg, ok := i18n.Field(c, f.Rule, f.Param)
+			if !ok {
+				msg = f.Message
+			}
+			fields = append(fields, schemas.FieldError{Field: f.Field, Rule: f.Rule, Message: msg})
 		}
-		return false
+		problem.Write(c, problem.Invalid(c, fields...))
+	case len(err.MediaTypes) > 0:
+		// The operation declares no 415.
+		problem.Write(c, problem.New(c, http.StatusBadRequest, problem.InvalidJSON, i18n.T(c, "detail.content_type", strings.Join(err.MediaTypes, ", "))))
+	default:
+		problem.Write(c, problem.New(c, http.StatusBadRequest, problem.InvalidJSON, i18n.T(c, "detail.invalid_json")))
 	}
-	if uid != userID {
-		forbidden(c)
-		return false
+}
+
+// InvalidResponse replaces a response that does not match the spec when response validation is set to fail.
+// The mismatch itself is already logged.
+func InvalidResponse(c *gin.Context, _ error) {
+	problem.Write(c, problem.New(c, http.StatusInternalServerError, problem.Internal, ""))
+}
+
+// RespondErrors writes the body for failures the strict handlers leave in c.Errors without a response:
+// an undecodable request body (400), an auth.Middleware rejection (401, or 500 for a broken verifier),
+// a rate limit (429; Retry-After is already set) and a handler error (500). The cause of a 500 is logged
+// under the request's trace ID, which the body carries, and never sent to the client.
+func RespondErrors(c *gin.Context) {
+	c.Next()
+	if len(c.Errors) == 0 || c.Writer.Written() {
+		return
+	}
+	err := c.Errors.Last().Err
+	switch {
+	case errors.Is(err, auth.ErrUnauthorized):
+		problem.Write(c, problem.New(c, http.StatusUnauthorized, problem.Unauthorized, ""))
+	case errors.Is(err, ratelimit.ErrLimited):
+		problem.Write(c, problem.New(c, http.StatusTooManyRequests, problem.RateLimited, ""))
+	case c.Writer.Status() == http.StatusBadRequest:
+		problem.Write(c, problem.New(c, http.StatusBadRequest, problem.InvalidJSON, i18n.T(c, "detail.invalid_json")))
+	default:
+		slog.ErrorContext(c, "request failed", "err", err)
+		problem.Write(c, problem.New(c, http.StatusInternalServerError, problem.Internal, ""))
 	}
-	return true
+}
+
+// requireSelf authorizes the authenticated principal to act on userID's resources; on false the handler
+// answers 403. scope is what a personal access token must hold; pass auth.ScopeAll for session-only operations.
+func (a *API) requireSelf(ctx context.Context, userID string, scope auth.Scope) bool {
+	p, ok := auth.PrincipalFrom(ctx)
+	if !ok {
+		// Operation is not declared as secured in openapi.yml, so the middleware did not authenticate.
+		return authorizeResult(metrics.AuthUnauthorized)
+	}
+	if p.UID != userID || !p.HasScope(scope) {
+		return authorizeResult(metrics.AuthForbidden)
+	}
+	return authorizeResult(metrics.AuthAllowed)
+}
+
+// requireSelfOr is requireSelf that also lets principals holding perm through (e.g. admins reading any user's todos).
+func (a *API) requireSelfOr(ctx context.Context, userID string, perm auth.Permission, scope auth.Scope) bool {
+	p, ok := auth.PrincipalFrom(ctx)
+	if !ok {
+		return authorizeResult(metrics.AuthUnauthorized)
+	}
+	if (p.UID != userID && !p.Can(perm)) || !p.HasScope(scope) {
+		return authorizeResult(metrics.AuthForbidden)
+	}
+	return authorizeResult(metrics.AuthAllowed)
+}
+
+// requirePermission authorizes operations that are not scoped to the caller's own resources.
+// They are never available to personal access tokens.
+func (a *API) requirePermission(ctx context.Context, perm auth.Permission) bool {
+	p, ok := auth.PrincipalFrom(ctx)
+	if !ok {
+		return authorizeResult(metrics.AuthUnauthorized)
+	}
+	if !p.Can(perm) || !p.HasScope(auth.ScopeAll) {
+		return authorizeResult(metrics.AuthForbidden)
+	}
+	return authorizeResult(metrics.AuthAllowed)
+}
+
+// authorizeResult records the authorization outcome and reports whether to proceed.
+func authorizeResult(outcome string) bool {
+	metrics.RecordAuth("authorize", outcome)
+	return outcome == metrics.AuthAllowed
 }
 
 func isMySQLDuplicate(err error) bool {
@@ -65,5 +219,3 @@ func isMySQLFKViolation(err error) bool {
 	var me *mysqlDriver.MySQLError
 	return errors.As(err, &me) && me.Number == 1452
 }
-
-
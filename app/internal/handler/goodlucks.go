@@ -1,56 +1,46 @@
 package handler
 
 import (
+	"context"
 	"database/sql"
 	"strings"
 
-	"github.com/gin-gonic/gin"
-
+	"go-gin-webapi/internal/auth"
 	"go-gin-webapi/schemas"
 )
 
-func (a *API) PostUsersUserIdTodosTodoIdGoodlucks(c *gin.Context, userId schemas.UserId, todoId schemas.TodoId) {
-	if !a.requireSelf(c, string(userId)) {
-		return
-	}
-	var req schemas.CreateGoodluckRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		badRequest(c, "invalid json")
-		return
+func (a *API) PostUsersUserIdTodosTodoIdGoodlucks(ctx context.Context, request schemas.PostUsersUserIdTodosTodoIdGoodlucksRequestObject) (schemas.PostUsersUserIdTodosTodoIdGoodlucksResponseObject, error) {
+	userId, todoId := string(request.UserId), string(request.TodoId)
+	if !a.requireSelf(ctx, userId, auth.ScopeTodosWrite) {
+		return schemas.PostUsersUserIdTodosTodoIdGoodlucks403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
 	}
-	if req.UserId != nil && strings.TrimSpace(*req.UserId) != "" && *req.UserId != string(userId) {
-		badRequest(c, "user_id mismatch")
-		return
+	req := *request.Body
+	if req.UserId != nil && strings.TrimSpace(*req.UserId) != "" && *req.UserId != userId {
+		return schemas.PostUsersUserIdTodosTodoIdGoodlucks400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalidField(ctx, "user_id", "match_path", nil)}, nil
 	}
-	if req.TodoId != nil && strings.TrimSpace(*req.TodoId) != "" && *req.TodoId != string(todoId) {
-		badRequest(c, "todo_id mismatch")
-		return
+	if req.TodoId != nil && strings.TrimSpace(*req.TodoId) != "" && *req.TodoId != todoId {
+		return schemas.PostUsersUserIdTodosTodoIdGoodlucks400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalidField(ctx, "todo_id", "match_path", nil)}, nil
 	}
-	if err := a.repos.Goodlucks.Create(c.Request.Context(), string(userId), string(todoId)); err != nil {
+	if err := a.repos.Goodlucks.Create(ctx, userId, todoId); err != nil {
 		if isMySQLFKViolation(err) {
-			notFound(c)
-			return
+			return schemas.PostUsersUserIdTodosTodoIdGoodlucks404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
 		}
-		internalErr(c, err)
-		return
+		return nil, err
 	}
-	msg := "goodluck created"
-	c.JSON(201, schemas.CreateGoodluckResponse{Message: &msg})
+	return schemas.PostUsersUserIdTodosTodoIdGoodlucks201JSONResponse{Message: strPtr("goodluck created")}, nil
 }
 
-func (a *API) DeleteUsersUserIdTodosTodoIdGoodlucks(c *gin.Context, userId schemas.UserId, todoId schemas.TodoId) {
-	if !a.requireSelf(c, string(userId)) {
-		return
+func (a *API) DeleteUsersUserIdTodosTodoIdGoodlucks(ctx context.Context, request schemas.DeleteUsersUserIdTodosTodoIdGoodlucksRequestObject) (schemas.DeleteUsersUserIdTodosTodoIdGoodlucksResponseObject, error) {
+	if !a.requireSelf(ctx, string(request.UserId), auth.ScopeTodosWrite) {
+		return schemas.DeleteUsersUserIdTodosTodoIdGoodlucks403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
 	}
-	if err := a.repos.Goodlucks.Delete(c.Request.Context(), string(userId), string(todoId)); err != nil {
+	if err := a.repos.Goodlucks.Delete(ctx, string(request.UserId), string(request.TodoId)); err != nil {
 		if err == sql.ErrNoRows {
-			notFound(c)
-			return
+			return schemas.DeleteUsersUserIdTodosTodoIdGoodlucks404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
 		}
-		internalErr(c, err)
-		return
+		return nil, err
 	}
-	c.Status(204)
+	return schemas.DeleteUsersUserIdTodosTodoIdGoodlucks204Response{}, nil
 }
 
 
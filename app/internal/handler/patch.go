@@ -0,0 +1,302 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"go-gin-webapi/internal/auth"
+	"go-gin-webapi/internal/i18n"
+	"go-gin-webapi/internal/problem"
+	"go-gin-webapi/schemas"
+)
+
+const (
+	mergePatchMediaType = "application/merge-patch+json" // RFC 7396
+	jsonPatchMediaType  = "application/json-patch+json"  // RFC 6902
+)
+
+// patchError is a PATCH request that could not be applied: 400, 409 (failed JSON Patch test) or 415.
+type patchError struct {
+	status int
+	code   string
+	detail i18n.Message
+}
+
+func (e *patchError) Error() string { return e.detail.Error() }
+
+func invalidPatch(key string, args ...any) error {
+	return &patchError{status: http.StatusBadRequest, code: problem.InvalidPatch, detail: i18n.Msg(key, args...)}
+}
+
+// applyPatch applies a merge patch or a JSON Patch, whichever body the request was decoded into, to doc,
+// the resource's current JSON representation. It returns the fields of the patched document; only allowed
+// names may appear in it.
+func applyPatch(doc any, mergePatch, jsonPatch *json.RawMessage, allowed ...string) (map[string]json.RawMessage, error) {
+	original, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var patched []byte
+	switch {
+	case mergePatch != nil:
+		if patched, err = jsonpatch.MergePatch(original, *mergePatch); err != nil {
+			return nil, invalidPatch("detail.invalid_merge_patch")
+		}
+	case jsonPatch != nil:
+		patch, err := jsonpatch.DecodePatch(*jsonPatch)
+		if err != nil {
+			return nil, invalidPatch("detail.invalid_json_patch")
+		}
+		if patched, err = patch.Apply(original); err != nil {
+			if errors.Is(err, jsonpatch.ErrTestFailed) {
+				return nil, &patchError{status: http.StatusConflict, code: problem.PatchTestFailed, detail: i18n.Msg("detail.json_patch_test_failed")}
+			}
+			return nil, invalidPatch("detail.json_patch_failed", err.Error())
+		}
+	default:
+		return nil, &patchError{
+			status: http.StatusUnsupportedMediaType,
+			code:   problem.UnsupportedMediaType,
+			detail: i18n.Msg("detail.content_type", jsonPatchMediaType+", "+mergePatchMediaType),
+		}
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(patched, &fields); err != nil || fields == nil {
+		return nil, invalidPatch("detail.patched_not_object")
+	}
+	for name := range fields {
+		if !slices.Contains(allowed, name) {
+			return nil, &fieldError{field: name, rule: "additionalProperties"}
+		}
+	}
+	return fields, nil
+}
+
+// patchedString returns a string field of a patched document; nil if it was removed or set to null.
+func patchedString(fields map[string]json.RawMessage, name string) (*string, error) {
+	raw, ok := fields[name]
+	if !ok || string(raw) == "null" {
+		return nil, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, &fieldError{field: name, rule: "type", param: "string"}
+	}
+	return &s, nil
+}
+
+// todoPatchFailed answers an applyPatch or patchedString error of PatchUsersUserIdTodosTodoId.
+func todoPatchFailed(ctx context.Context, err error) (schemas.PatchUsersUserIdTodosTodoIdResponseObject, error) {
+	var fe *fieldError
+	if errors.As(err, &fe) {
+		return schemas.PatchUsersUserIdTodosTodoId400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, fe)}, nil
+	}
+	var pe *patchError
+	if !errors.As(err, &pe) {
+		return nil, err
+	}
+	p := problem.New(ctx, pe.status, pe.code, pe.detail.In(ctx))
+	switch pe.status {
+	case http.StatusUnsupportedMediaType:
+		return schemas.PatchUsersUserIdTodosTodoId415ApplicationProblemPlusJSONResponse{UnsupportedMediaTypeApplicationProblemPlusJSONResponse: schemas.UnsupportedMediaTypeApplicationProblemPlusJSONResponse(p)}, nil
+	case http.StatusConflict:
+		return schemas.PatchUsersUserIdTodosTodoId409ApplicationProblemPlusJSONResponse(problemOnly(p)), nil
+	}
+	return schemas.PatchUsersUserIdTodosTodoId400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: schemas.BadRequestApplicationProblemPlusJSONResponse(p)}, nil
+}
+
+// userPatchFailed is todoPatchFailed for PatchUsersUserId.
+func userPatchFailed(ctx context.Context, err error) (schemas.PatchUsersUserIdResponseObject, error) {
+	var fe *fieldError
+	if errors.As(err, &fe) {
+		return schemas.PatchUsersUserId400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, fe)}, nil
+	}
+	var pe *patchError
+	if !errors.As(err, &pe) {
+		return nil, err
+	}
+	p := problem.New(ctx, pe.status, pe.code, pe.detail.In(ctx))
+	switch pe.status {
+	case http.StatusUnsupportedMediaType:
+		return schemas.PatchUsersUserId415ApplicationProblemPlusJSONResponse{UnsupportedMediaTypeApplicationProblemPlusJSONResponse: schemas.UnsupportedMediaTypeApplicationProblemPlusJSONResponse(p)}, nil
+	case http.StatusConflict:
+		return schemas.PatchUsersUserId409ApplicationProblemPlusJSONResponse{PatchTestFailedApplicationProblemPlusJSONResponse: schemas.PatchTestFailedApplicationProblemPlusJSONResponse(p)}, nil
+	}
+	return schemas.PatchUsersUserId400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: schemas.BadRequestApplicationProblemPlusJSONResponse(p)}, nil
+}
+
+// problemOnly is p as the status transition problem the todo's 409 shares with a failed JSON Patch test.
+func problemOnly(p schemas.Problem) schemas.StatusTransitionError {
+	return schemas.StatusTransitionError{
+		Type:     p.Type,
+		Title:    p.Title,
+		Status:   p.Status,
+		Code:     p.Code,
+		Detail:   p.Detail,
+		Instance: p.Instance,
+		TraceId:  p.TraceId,
+	}
+}
+
+func (a *API) PatchUsersUserIdTodosTodoId(ctx context.Context, request schemas.PatchUsersUserIdTodosTodoIdRequestObject) (schemas.PatchUsersUserIdTodosTodoIdResponseObject, error) {
+	userId, todoId := string(request.UserId), string(request.TodoId)
+	if !a.requireSelf(ctx, userId, auth.ScopeTodosWrite) {
+		return schemas.PatchUsersUserIdTodosTodoId403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	t, err := a.repos.Todos.GetByIDOwner(ctx, todoId, userId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return schemas.PatchUsersUserIdTodosTodoId404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
+		}
+		return nil, err
+	}
+	cur, err := a.toTodoDetailResponse(ctx, t.Title, t.Content, t.Status, t.DueDatetime)
+	if err != nil {
+		return nil, err
+	}
+
+	// status_reason is write-only: it is not in the document but a patch may add it.
+	fields, err := applyPatch(cur, request.ApplicationMergePatchPlusJSONBody, request.ApplicationJSONPatchPlusJSONBody,
+		"title", "content", "status", "status_code", "status_reason", "due_datetime")
+	if err != nil {
+		return todoPatchFailed(ctx, err)
+	}
+	values := make(map[string]*string, len(fields))
+	for _, name := range []string{"title", "content", "status", "status_code", "status_reason", "due_datetime"} {
+		if values[name], err = patchedString(fields, name); err != nil {
+			return todoPatchFailed(ctx, err)
+		}
+	}
+	for _, name := range []string{"title", "content", "status"} {
+		if values[name] == nil {
+			return schemas.PatchUsersUserIdTodosTodoId400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalidField(ctx, name, "required", nil)}, nil
+		}
+	}
+	due := values["due_datetime"]
+	if due != nil && strings.TrimSpace(*due) == "" {
+		return schemas.PatchUsersUserIdTodosTodoId400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalidField(ctx, "due_datetime", "format", "yyyy/mm/dd hh:mm")}, nil
+	}
+
+	// Only changed fields are written, so a concurrent update of the others is kept.
+	req := schemas.UpdateTodoRequest{StatusReason: values["status_reason"]}
+	if *values["title"] != *cur.Title {
+		req.Title = values["title"]
+	}
+	if *values["content"] != *cur.Content {
+		req.Content = values["content"]
+	}
+	if *values["status"] != *cur.Status {
+		req.Status = values["status"]
+	}
+	// Either names the status; the one left as it was does not count against a change of the other.
+	if code := values["status_code"]; code != nil && (cur.StatusCode == nil || *code != *cur.StatusCode) {
+		req.StatusCode = code
+	}
+	if due != nil && (cur.DueDatetime == nil || *due != *cur.DueDatetime) {
+		req.DueDatetime = due
+	}
+	u, err := a.todoUpdate(ctx, req)
+	if err != nil {
+		return schemas.PatchUsersUserIdTodosTodoId400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, err)}, nil
+	}
+	u.ClearDueDatetime = due == nil && cur.DueDatetime != nil
+	if u.Status != nil {
+		if err := a.applyWorkflow(ctx, userId, u.Status); err != nil {
+			return nil, err
+		}
+	}
+
+	p, _ := auth.PrincipalFrom(ctx)
+	if err := a.repos.Todos.UpdateByIDOwner(ctx, todoId, userId, p.UID, u); err != nil {
+		var te *transitionError
+		switch {
+		case err == sql.ErrNoRows:
+			return schemas.PatchUsersUserIdTodosTodoId404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
+		case !errors.As(err, &te):
+			return nil, err
+		case te.status == http.StatusConflict:
+			return schemas.PatchUsersUserIdTodosTodoId409ApplicationProblemPlusJSONResponse(te.body(ctx)), nil
+		}
+		return schemas.PatchUsersUserIdTodosTodoId422ApplicationProblemPlusJSONResponse{StatusReasonRequiredApplicationProblemPlusJSONResponse: schemas.StatusReasonRequiredApplicationProblemPlusJSONResponse(te.body(ctx))}, nil
+	}
+	if t, err = a.repos.Todos.GetByIDOwner(ctx, todoId, userId); err != nil {
+		return nil, err
+	}
+	out, err := a.toTodoDetailResponse(ctx, t.Title, t.Content, t.Status, t.DueDatetime)
+	if err != nil {
+		return nil, err
+	}
+	return schemas.PatchUsersUserIdTodosTodoId200JSONResponse(out), nil
+}
+
+func (a *API) PatchUsersUserId(ctx context.Context, request schemas.PatchUsersUserIdRequestObject) (schemas.PatchUsersUserIdResponseObject, error) {
+	if !a.requireSelf(ctx, string(request.UserId), auth.ScopeAll) {
+		return schemas.PatchUsersUserId403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	cur, err := a.repos.Users.GetByUID(ctx, string(request.UserId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return schemas.PatchUsersUserId404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
+		}
+		return nil, err
+	}
+
+	doc := schemas.UpdateUserRequest{Nickname: &cur.Nickname, Email: emailPtr(cur.Email)}
+	fields, err := applyPatch(doc, request.ApplicationMergePatchPlusJSONBody, request.ApplicationJSONPatchPlusJSONBody, "nickname", "email")
+	if err != nil {
+		return userPatchFailed(ctx, err)
+	}
+	nickname, err := patchedString(fields, "nickname")
+	if err != nil {
+		return userPatchFailed(ctx, err)
+	}
+	email, err := patchedString(fields, "email")
+	if err != nil {
+		return userPatchFailed(ctx, err)
+	}
+	if nickname == nil {
+		return schemas.PatchUsersUserId400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalidField(ctx, "nickname", "required", nil)}, nil
+	}
+	if email == nil && cur.Email != "" {
+		return schemas.PatchUsersUserId400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalidField(ctx, "email", "required", nil)}, nil
+	}
+
+	var req schemas.UpdateUserRequest
+	if *nickname != cur.Nickname {
+		req.Nickname = nickname
+	}
+	if email != nil && *email != cur.Email {
+		// Same format check as the application/json body of PUT.
+		var e openapi_types.Email
+		if err := e.UnmarshalJSON(fields["email"]); err != nil {
+			return schemas.PatchUsersUserId400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalidField(ctx, "email", "format", "email")}, nil
+		}
+		req.Email = &e
+	}
+	n, e, err := userUpdate(req)
+	if err != nil {
+		return schemas.PatchUsersUserId400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, err)}, nil
+	}
+
+	u, err := a.repos.Users.Update(ctx, string(request.UserId), n, e)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return schemas.PatchUsersUserId404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
+		}
+		return nil, err
+	}
+	return schemas.PatchUsersUserId200JSONResponse{
+		Nickname: &u.Nickname,
+		Email:    emailPtr(u.Email),
+	}, nil
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"go-gin-webapi/internal/problem"
+)
+
+func TestApplyPatch(t *testing.T) {
+	type doc struct {
+		Title   string  `json:"title"`
+		Content string  `json:"content"`
+		Due     *string `json:"due_datetime,omitempty"`
+	}
+	due := "2026/01/02 03:04"
+	cur := doc{Title: "old", Content: "body", Due: &due}
+	raw := func(s string) *json.RawMessage {
+		m := json.RawMessage(s)
+		return &m
+	}
+	allowed := []string{"title", "content", "due_datetime", "status_reason"}
+
+	tests := []struct {
+		name       string
+		merge      *json.RawMessage
+		jsonPatch  *json.RawMessage
+		want       map[string]string // "" for null or removed
+		wantStatus int               // 0: applied
+		wantCode   string
+		wantField  string
+	}{
+		{
+			name:  "merge replaces and removes",
+			merge: raw(`{"title":"new","due_datetime":null}`),
+			want:  map[string]string{"title": "new", "content": "body", "due_datetime": ""},
+		},
+		{
+			name:  "merge adds a write-only field",
+			merge: raw(`{"status_reason":"why"}`),
+			want:  map[string]string{"title": "old", "status_reason": "why"},
+		},
+		{
+			name:      "json patch",
+			jsonPatch: raw(`[{"op":"test","path":"/title","value":"old"},{"op":"replace","path":"/title","value":"new"},{"op":"remove","path":"/due_datetime"}]`),
+			want:      map[string]string{"title": "new", "content": "body", "due_datetime": ""},
+		},
+		{
+			name:       "json patch test fails",
+			jsonPatch:  raw(`[{"op":"test","path":"/title","value":"other"},{"op":"replace","path":"/title","value":"new"}]`),
+			wantStatus: http.StatusConflict, wantCode: problem.PatchTestFailed,
+		},
+		{
+			name:       "json patch path missing",
+			jsonPatch:  raw(`[{"op":"replace","path":"/nope/x","value":"new"}]`),
+			wantStatus: http.StatusBadRequest, wantCode: problem.InvalidPatch,
+		},
+		{
+			name:       "json patch not a list",
+			jsonPatch:  raw(`{"op":"replace"}`),
+			wantStatus: http.StatusBadRequest, wantCode: problem.InvalidPatch,
+		},
+		{
+			name:       "merge replaces the document",
+			merge:      raw(`[1]`),
+			wantStatus: http.StatusBadRequest, wantCode: problem.InvalidPatch,
+		},
+		{
+			name:       "json patch replaces the document",
+			jsonPatch:  raw(`[{"op":"replace","path":"","value":"x"}]`),
+			wantStatus: http.StatusBadRequest, wantCode: problem.InvalidPatch,
+		},
+		{
+			name:      "unknown field",
+			merge:     raw(`{"owner":"bob"}`),
+			wantField: "owner",
+		},
+		{
+			name:       "no body",
+			wantStatus: http.StatusUnsupportedMediaType, wantCode: problem.UnsupportedMediaType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := applyPatch(cur, tt.merge, tt.jsonPatch, allowed...)
+			switch {
+			case tt.wantField != "":
+				var fe *fieldError
+				if !errors.As(err, &fe) || fe.field != tt.wantField || fe.rule != "additionalProperties" {
+					t.Fatalf("err = %v, want additionalProperties of %s", err, tt.wantField)
+				}
+				return
+			case tt.wantStatus != 0:
+				var pe *patchError
+				if !errors.As(err, &pe) || pe.status != tt.wantStatus || pe.code != tt.wantCode {
+					t.Fatalf("err = %#v, want %d %s", err, tt.wantStatus, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for name, want := range tt.want {
+				got, err := patchedString(fields, name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if (got == nil) != (want == "") || (got != nil && *got != want) {
+					t.Errorf("%s = %v, want %q", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPatchedString(t *testing.T) {
+	fields := map[string]json.RawMessage{
+		"title":  json.RawMessage(`"a"`),
+		"empty":  json.RawMessage(`""`),
+		"null":   json.RawMessage(`null`),
+		"number": json.RawMessage(`1`),
+	}
+	tests := []struct {
+		name    string
+		want    *string
+		wantErr bool
+	}{
+		{name: "title", want: strPtr("a")},
+		{name: "empty", want: strPtr("")},
+		{name: "null"},
+		{name: "missing"},
+		{name: "number", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := patchedString(fields, tt.name)
+			if tt.wantErr {
+				var fe *fieldError
+				if !errors.As(err, &fe) || fe.rule != "type" {
+					t.Fatalf("err = %v, want a type error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
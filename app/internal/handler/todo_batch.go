@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"go-gin-webapi/internal/auth"
+	"go-gin-webapi/internal/i18n"
+	"go-gin-webapi/internal/repo"
+	"go-gin-webapi/schemas"
+)
+
+// errBatchAborted rolls back an atomic batch after one of its operations failed.
+var errBatchAborted = errors.New("batch aborted")
+
+// batchOp is a validated batch operation.
+type batchOp struct {
+	id      string
+	success int
+	run     func(ctx context.Context, b *repo.TodoBatch) error
+}
+
+func (a *API) prepareBatchOp(ctx context.Context, owner string, rules []repo.TodoTransition, op schemas.BatchTodoOperation) (batchOp, error) {
+	switch op.Op {
+	case schemas.Create:
+		if op.Create == nil {
+			return batchOp{}, &fieldError{field: "create", rule: "required"}
+		}
+		t, reason, err := a.newTodo(ctx, owner, rules, *op.Create)
+		if err != nil {
+			return batchOp{}, err
+		}
+		return batchOp{id: t.ID, success: http.StatusCreated, run: func(ctx context.Context, b *repo.TodoBatch) error {
+			return b.Create(ctx, t, reason)
+		}}, nil
+	case schemas.Update:
+		if op.TodoId == nil {
+			return batchOp{}, &fieldError{field: "todo_id", rule: "required"}
+		}
+		if op.Update == nil {
+			return batchOp{}, &fieldError{field: "update", rule: "required"}
+		}
+		u, err := a.todoUpdate(ctx, *op.Update)
+		if err != nil {
+			return batchOp{}, err
+		}
+		if u.Status != nil {
+			a.allowByRules(ctx, rules, u.Status)
+		}
+		id := *op.TodoId
+		return batchOp{id: id, success: http.StatusOK, run: func(ctx context.Context, b *repo.TodoBatch) error {
+			return b.Update(ctx, id, u)
+		}}, nil
+	case schemas.Delete:
+		if op.TodoId == nil {
+			return batchOp{}, &fieldError{field: "todo_id", rule: "required"}
+		}
+		id := *op.TodoId
+		return batchOp{id: id, success: http.StatusNoContent, run: func(ctx context.Context, b *repo.TodoBatch) error {
+			return b.Delete(ctx, id)
+		}}, nil
+	}
+	return batchOp{}, &fieldError{field: "op", rule: "enum", param: []string{"create", "update", "delete"}}
+}
+
+func batchResult(ctx context.Context, status int, id string, err error, allowed []string) schemas.BatchTodoResult {
+	r := schemas.BatchTodoResult{Status: &status}
+	if id != "" {
+		r.Id = &id
+	}
+	if err != nil {
+		msg := errorMessage(ctx, err)
+		r.Error = &msg
+	}
+	if allowed != nil {
+		r.AllowedStatuses = &allowed
+	}
+	return r
+}
+
+// batchFailure maps an operation error to the status the single-item endpoint would have answered.
+// Like a 500 of that endpoint, an unexpected error is logged and not sent to the client.
+func batchFailure(ctx context.Context, id string, err error) schemas.BatchTodoResult {
+	var te *transitionError
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return batchResult(ctx, http.StatusNotFound, id, i18n.Msg("batch.not_found"), nil)
+	case errors.As(err, &te):
+		return batchResult(ctx, te.status, id, te, te.allowed)
+	}
+	slog.ErrorContext(ctx, "batch operation failed", "id", id, "err", err)
+	return batchResult(ctx, http.StatusInternalServerError, id, i18n.Msg("batch.internal"), nil)
+}
+
+func (a *API) PostUsersUserIdTodosBatch(ctx context.Context, request schemas.PostUsersUserIdTodosBatchRequestObject) (schemas.PostUsersUserIdTodosBatchResponseObject, error) {
+	if !a.requireSelf(ctx, string(request.UserId), auth.ScopeTodosWrite) {
+		return schemas.PostUsersUserIdTodosBatch403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	// The number of operations (1 to 100) is checked by the spec.
+	req := *request.Body
+	atomic := req.Atomic == nil || *req.Atomic
+
+	owner := string(request.UserId)
+	rules, err := a.repos.Workflows.ListByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]schemas.BatchTodoResult, len(req.Operations))
+	ops := make([]batchOp, len(req.Operations))
+	invalid := false
+	for i, op := range req.Operations {
+		prepared, err := a.prepareBatchOp(ctx, owner, rules, op)
+		if err != nil {
+			id := ""
+			if op.TodoId != nil {
+				id = *op.TodoId
+			}
+			var te *transitionError
+			if errors.As(err, &te) {
+				results[i] = batchResult(ctx, te.status, id, te, te.allowed)
+			} else {
+				results[i] = batchResult(ctx, http.StatusBadRequest, id, err, nil)
+			}
+			invalid = true
+			continue
+		}
+		ops[i] = prepared
+	}
+
+	committed := false
+	if !(atomic && invalid) {
+		p, _ := auth.PrincipalFrom(ctx)
+		err = a.repos.Todos.Batch(ctx, owner, p.UID, func(b *repo.TodoBatch) error {
+			for i, op := range ops {
+				if op.run == nil {
+					continue
+				}
+				err := b.Try(ctx, func() error { return op.run(ctx, b) })
+				if err == nil {
+					results[i] = batchResult(ctx, op.success, op.id, nil, nil)
+					continue
+				}
+				var se *repo.SavepointError
+				if errors.As(err, &se) {
+					return err
+				}
+				results[i] = batchFailure(ctx, op.id, err)
+				if atomic {
+					return errBatchAborted
+				}
+			}
+			return nil
+		})
+		switch {
+		case err == nil:
+			committed = true
+		case !errors.Is(err, errBatchAborted):
+			return nil, err
+		}
+	}
+
+	if !committed {
+		// Everything that did not fail itself was rolled back with the batch.
+		for i, op := range ops {
+			if results[i].Status != nil && *results[i].Status >= 300 {
+				continue
+			}
+			id := op.id
+			if op.success == http.StatusCreated {
+				id = ""
+			}
+			results[i] = batchResult(ctx, http.StatusFailedDependency, id, i18n.Msg("batch.rolled_back"), nil)
+		}
+	}
+	return schemas.PostUsersUserIdTodosBatch200JSONResponse{Committed: &committed, Results: &results}, nil
+}
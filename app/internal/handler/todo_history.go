@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+
+	"go-gin-webapi/internal/auth"
+	"go-gin-webapi/internal/repo"
+	"go-gin-webapi/schemas"
+)
+
+// revisionValue renders one field of a revision as the API shows it (status label, formatted due date).
+func (a *API) revisionValue(ctx context.Context, rev *repo.TodoRevision, field string) *string {
+	if rev == nil {
+		return nil
+	}
+	var s string
+	switch field {
+	case repo.FieldTitle:
+		s = rev.Title
+	case repo.FieldContent:
+		s = rev.Content
+	case repo.FieldStatus:
+		s = a.statusLabel(ctx, rev.Status)
+	case repo.FieldDueDatetime:
+		if rev.DueDatetime == nil {
+			return nil
+		}
+		s = formatTodoDueDatetime(*rev.DueDatetime)
+	default:
+		return nil
+	}
+	return &s
+}
+
+func (a *API) toTodoHistoryResponse(ctx context.Context, revs []repo.TodoRevision) schemas.TodoHistoryResponse {
+	out := make(schemas.TodoHistoryResponse, 0, len(revs))
+	for i := range revs {
+		cur := &revs[i]
+		var prev *repo.TodoRevision
+		if i > 0 && revs[i-1].Rev == cur.Rev-1 {
+			prev = &revs[i-1]
+		}
+		changes := make([]schemas.TodoFieldChange, 0, len(cur.Changed))
+		for _, f := range cur.Changed {
+			field := schemas.TodoFieldChangeField(f)
+			changes = append(changes, schemas.TodoFieldChange{
+				Field: &field,
+				From:  a.revisionValue(ctx, prev, f),
+				To:    a.revisionValue(ctx, cur, f),
+			})
+		}
+		rev := cur.Rev
+		changedBy := cur.ChangedBy
+		changedAt := cur.ChangedAt
+		out = append(out, schemas.TodoRevision{
+			Rev:       &rev,
+			ChangedBy: &changedBy,
+			ChangedAt: &changedAt,
+			Changes:   &changes,
+		})
+	}
+	return out
+}
+
+func (a *API) GetUsersUserIdTodosTodoIdHistory(ctx context.Context, request schemas.GetUsersUserIdTodosTodoIdHistoryRequestObject) (schemas.GetUsersUserIdTodosTodoIdHistoryResponseObject, error) {
+	userId, todoId := string(request.UserId), string(request.TodoId)
+	if !a.requireSelfOr(ctx, userId, auth.PermTodosReadAny, auth.ScopeTodosRead) {
+		return schemas.GetUsersUserIdTodosTodoIdHistory403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	if _, err := a.repos.Todos.GetByIDOwner(ctx, todoId, userId); err != nil {
+		if err == sql.ErrNoRows {
+			return schemas.GetUsersUserIdTodosTodoIdHistory404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
+		}
+		return nil, err
+	}
+	revs, err := a.repos.Revisions.ListByTodo(ctx, todoId, userId)
+	if err != nil {
+		return nil, err
+	}
+	return schemas.GetUsersUserIdTodosTodoIdHistory200JSONResponse(a.toTodoHistoryResponse(ctx, revs)), nil
+}
+
+func (a *API) PostUsersUserIdTodosTodoIdHistoryRevRevert(ctx context.Context, request schemas.PostUsersUserIdTodosTodoIdHistoryRevRevertRequestObject) (schemas.PostUsersUserIdTodosTodoIdHistoryRevRevertResponseObject, error) {
+	userId, todoId := string(request.UserId), string(request.TodoId)
+	if !a.requireSelf(ctx, userId, auth.ScopeTodosWrite) {
+		return schemas.PostUsersUserIdTodosTodoIdHistoryRevRevert403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	target, err := a.repos.Revisions.Get(ctx, todoId, userId, request.Rev)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return schemas.PostUsersUserIdTodosTodoIdHistoryRevRevert404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
+		}
+		return nil, err
+	}
+	u := revertUpdate(target, request.Body)
+	if err := a.applyWorkflow(ctx, userId, u.Status); err != nil {
+		return nil, err
+	}
+	p, _ := auth.PrincipalFrom(ctx)
+	if err := a.repos.Todos.UpdateByIDOwner(ctx, todoId, userId, p.UID, u); err != nil {
+		var te *transitionError
+		switch {
+		case err == sql.ErrNoRows:
+			return schemas.PostUsersUserIdTodosTodoIdHistoryRevRevert404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
+		case !errors.As(err, &te):
+			return nil, err
+		case te.status == http.StatusConflict:
+			return schemas.PostUsersUserIdTodosTodoIdHistoryRevRevert409ApplicationProblemPlusJSONResponse{StatusTransitionNotAllowedApplicationProblemPlusJSONResponse: schemas.StatusTransitionNotAllowedApplicationProblemPlusJSONResponse(te.body(ctx))}, nil
+		}
+		return schemas.PostUsersUserIdTodosTodoIdHistoryRevRevert422ApplicationProblemPlusJSONResponse{StatusReasonRequiredApplicationProblemPlusJSONResponse: schemas.StatusReasonRequiredApplicationProblemPlusJSONResponse(te.body(ctx))}, nil
+	}
+
+	out, err := a.toTodoDetailResponse(ctx, target.Title, target.Content, target.Status, target.DueDatetime)
+	if err != nil {
+		return nil, err
+	}
+	return schemas.PostUsersUserIdTodosTodoIdHistoryRevRevert200JSONResponse(out), nil
+}
+
+// revertUpdate restores every field of target. The body is optional (an empty one arrives as {}); it only
+// carries the reason for a status change, trimmed as in todoUpdate.
+func revertUpdate(target repo.TodoRevision, body *schemas.RevertTodoRequest) repo.TodoUpdate {
+	reason := ""
+	if body != nil && body.StatusReason != nil {
+		reason = strings.TrimSpace(*body.StatusReason)
+	}
+	return repo.TodoUpdate{
+		Title:            &target.Title,
+		Content:          &target.Content,
+		Status:           &repo.StatusChange{Status: target.Status, Reason: reason},
+		DueDatetime:      target.DueDatetime,
+		ClearDueDatetime: target.DueDatetime == nil,
+	}
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"go-gin-webapi/internal/repo"
+	"go-gin-webapi/schemas"
+)
+
+func TestRevertUpdate(t *testing.T) {
+	a := testAPI()
+	due := time.Date(2026, 1, 2, 3, 4, 0, 0, time.UTC)
+	// Reverting a 進行中 todo to a revision where it was 完了 needs a reason under testRules.
+	target := repo.TodoRevision{Title: "a", Content: "b", Status: "02", DueDatetime: &due}
+	tests := []struct {
+		name       string
+		body       *schemas.RevertTodoRequest
+		wantReason string
+		wantErr    int // 0: allowed
+	}{
+		{name: "reason", body: &schemas.RevertTodoRequest{StatusReason: strPtr("shipped")}, wantReason: "shipped"},
+		{name: "reason trimmed", body: &schemas.RevertTodoRequest{StatusReason: strPtr("  shipped \n")}, wantReason: "shipped"},
+		{name: "blank reason", body: &schemas.RevertTodoRequest{StatusReason: strPtr("   ")}, wantErr: http.StatusUnprocessableEntity},
+		{name: "empty body", body: &schemas.RevertTodoRequest{}, wantErr: http.StatusUnprocessableEntity},
+		{name: "no body", wantErr: http.StatusUnprocessableEntity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := revertUpdate(target, tt.body)
+			if *u.Title != "a" || *u.Content != "b" || u.DueDatetime != &due || u.ClearDueDatetime {
+				t.Errorf("update = %+v, want every field of the revision", u)
+			}
+			if u.Status.Status != "02" || u.Status.Reason != tt.wantReason {
+				t.Errorf("status = %s %q, want 02 %q", u.Status.Status, u.Status.Reason, tt.wantReason)
+			}
+			a.allowByRules(context.Background(), testRules, u.Status)
+			err := u.Status.Allow("01")
+			if tt.wantErr == 0 {
+				if err != nil {
+					t.Fatalf("Allow = %v, want nil", err)
+				}
+				return
+			}
+			var te *transitionError
+			if !errors.As(err, &te) || te.status != tt.wantErr {
+				t.Errorf("Allow = %v, want status %d", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRevertUpdateClearsDueDatetime(t *testing.T) {
+	u := revertUpdate(repo.TodoRevision{Title: "a", Status: "00"}, nil)
+	if u.DueDatetime != nil || !u.ClearDueDatetime {
+		t.Errorf("due = %v, clear = %v; want the due date cleared", u.DueDatetime, u.ClearDueDatetime)
+	}
+}
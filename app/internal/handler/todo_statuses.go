@@ -0,0 +1,294 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"go-gin-webapi/internal/auth"
+	"go-gin-webapi/internal/problem"
+	"go-gin-webapi/internal/repo"
+	"go-gin-webapi/schemas"
+)
+
+// todoStatusMaxAge bounds how stale the cached todo_statuses may get before a miss or a listing reloads them,
+// so statuses added on another instance show up without a restart.
+const todoStatusMaxAge = 30 * time.Second
+
+// maxTodoStatuses is the number of two-digit status codes.
+const maxTodoStatuses = 100
+
+// todoStatusCatalog caches todo_statuses, which map the API labels (未着手, ...) and status codes (not_started, ...)
+// to the codes stored in todos.
+type todoStatusCatalog struct {
+	repo *repo.TodoStatusRepo
+
+	mu       sync.RWMutex
+	list     []repo.TodoStatus // by sort order
+	codes    map[string]repo.TodoStatus
+	labels   map[string]repo.TodoStatus
+	slugs    map[string]repo.TodoStatus
+	loadedAt time.Time
+}
+
+// statusKey is what a catalog lookup matches.
+type statusKey int
+
+const (
+	keyCode statusKey = iota
+	keyLabel
+	keySlug
+)
+
+func (c *todoStatusCatalog) load(ctx context.Context) error {
+	list, err := c.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+	byCode := make(map[string]repo.TodoStatus, len(list))
+	byLabel := make(map[string]repo.TodoStatus, len(list))
+	bySlug := make(map[string]repo.TodoStatus, len(list))
+	for _, s := range list {
+		byCode[s.Status] = s
+		byLabel[s.Label] = s
+		if s.Slug != "" {
+			bySlug[s.Slug] = s
+		}
+	}
+	c.mu.Lock()
+	c.list, c.codes, c.labels, c.slugs, c.loadedAt = list, byCode, byLabel, bySlug, time.Now()
+	c.mu.Unlock()
+	return nil
+}
+
+// refreshIfStale reloads when the cache is older than todoStatusMaxAge. A failed reload keeps the old data.
+func (c *todoStatusCatalog) refreshIfStale(ctx context.Context) {
+	c.mu.RLock()
+	stale := time.Since(c.loadedAt) >= todoStatusMaxAge
+	c.mu.RUnlock()
+	if !stale {
+		return
+	}
+	if err := c.load(ctx); err != nil {
+		slog.WarnContext(ctx, "reload todo statuses", "err", err)
+	}
+}
+
+func (c *todoStatusCatalog) all(ctx context.Context) []repo.TodoStatus {
+	c.refreshIfStale(ctx)
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list
+}
+
+func (c *todoStatusCatalog) find(key string, by statusKey) (repo.TodoStatus, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	m := c.codes
+	switch by {
+	case keyLabel:
+		m = c.labels
+	case keySlug:
+		m = c.slugs
+	}
+	s, ok := m[key]
+	return s, ok
+}
+
+// lookup finds a status by code, label or slug, reloading once on a miss.
+func (c *todoStatusCatalog) lookup(ctx context.Context, key string, by statusKey) (repo.TodoStatus, bool) {
+	if s, ok := c.find(key, by); ok {
+		return s, true
+	}
+	c.refreshIfStale(ctx)
+	return c.find(key, by)
+}
+
+// byLabel resolves an API status to its row.
+func (c *todoStatusCatalog) byLabel(ctx context.Context, label string) (repo.TodoStatus, bool) {
+	return c.lookup(ctx, label, keyLabel)
+}
+
+// bySlug resolves an API status_code to its row.
+func (c *todoStatusCatalog) bySlug(ctx context.Context, slug string) (repo.TodoStatus, bool) {
+	return c.lookup(ctx, slug, keySlug)
+}
+
+// byCode resolves a todos.status code to its row.
+func (c *todoStatusCatalog) byCode(ctx context.Context, code string) (repo.TodoStatus, bool) {
+	return c.lookup(ctx, code, keyCode)
+}
+
+// initial is the status of new todos when none is given: the first by sort order.
+func (c *todoStatusCatalog) initial(ctx context.Context) (repo.TodoStatus, bool) {
+	list := c.all(ctx)
+	if len(list) == 0 {
+		return repo.TodoStatus{}, false
+	}
+	return list[0], true
+}
+
+// checkSortOrder rejects a sort order that would put a new status first, silently changing the initial
+// status of new todos.
+func (c *todoStatusCatalog) checkSortOrder(ctx context.Context, order int) error {
+	if st, ok := c.initial(ctx); ok && order <= st.SortOrder {
+		return &fieldError{field: "sort_order", rule: "minimum", param: st.SortOrder + 1}
+	}
+	return nil
+}
+
+// invalid is the client error for an unknown status in field.
+func (c *todoStatusCatalog) invalid(ctx context.Context, field string) error {
+	list := c.all(ctx)
+	labels := make([]string, 0, len(list))
+	for _, s := range list {
+		labels = append(labels, s.Label)
+	}
+	return &fieldError{field: field, rule: "enum", param: labels}
+}
+
+// invalidSlug is invalid for an unknown status_code.
+func (c *todoStatusCatalog) invalidSlug(ctx context.Context, field string) error {
+	list := c.all(ctx)
+	slugs := make([]string, 0, len(list))
+	for _, s := range list {
+		if s.Slug != "" {
+			slugs = append(slugs, s.Slug)
+		}
+	}
+	return &fieldError{field: field, rule: "enum", param: slugs}
+}
+
+// resolveStatus returns the status a request names by status (label), status_code or both; ok is false
+// if it names none.
+func (a *API) resolveStatus(ctx context.Context, label, slug *string) (st repo.TodoStatus, ok bool, err error) {
+	if label != nil && strings.TrimSpace(*label) != "" {
+		if st, ok = a.statuses.byLabel(ctx, *label); !ok {
+			return st, false, a.statuses.invalid(ctx, "status")
+		}
+	}
+	if slug != nil && *slug != "" {
+		bySlug, found := a.statuses.bySlug(ctx, *slug)
+		switch {
+		case !found:
+			return st, false, a.statuses.invalidSlug(ctx, "status_code")
+		case ok && bySlug.Status != st.Status:
+			return st, false, &fieldError{field: "status_code", rule: "match_status"}
+		}
+		st, ok = bySlug, true
+	}
+	return st, ok, nil
+}
+
+// nextCode returns the lowest unused two-digit code.
+func (c *todoStatusCatalog) nextCode() (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for i := 0; i < maxTodoStatuses; i++ {
+		code := fmt.Sprintf("%02d", i)
+		if _, ok := c.codes[code]; !ok {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+// LoadTodoStatuses fills the status cache; call it once at startup so a broken todo_statuses table fails fast.
+func (a *API) LoadTodoStatuses(ctx context.Context) error {
+	if err := a.statuses.load(ctx); err != nil {
+		return err
+	}
+	if _, ok := a.statuses.initial(ctx); !ok {
+		return errors.New("todo_statuses is empty")
+	}
+	return nil
+}
+
+func toTodoStatusInfo(s repo.TodoStatus) schemas.TodoStatusInfo {
+	label := s.Label
+	order := s.SortOrder
+	terminal := s.IsTerminal
+	return schemas.TodoStatusInfo{
+		Status:     &label,
+		StatusCode: slugPtr(s),
+		SortOrder:  &order,
+		IsTerminal: &terminal,
+	}
+}
+
+// slugPtr is the status_code of s, nil (omitted) for a status without one.
+func slugPtr(s repo.TodoStatus) *schemas.TodoStatusCode {
+	if s.Slug == "" {
+		return nil
+	}
+	slug := s.Slug
+	return &slug
+}
+
+func (a *API) GetTodoStatuses(ctx context.Context, request schemas.GetTodoStatusesRequestObject) (schemas.GetTodoStatusesResponseObject, error) {
+	list := a.statuses.all(ctx)
+	out := make(schemas.TodoStatusListResponse, 0, len(list))
+	for _, s := range list {
+		out = append(out, toTodoStatusInfo(s))
+	}
+	return schemas.GetTodoStatuses200JSONResponse(out), nil
+}
+
+func (a *API) PostAdminTodoStatuses(ctx context.Context, request schemas.PostAdminTodoStatusesRequestObject) (schemas.PostAdminTodoStatusesResponseObject, error) {
+	if !a.requirePermission(ctx, auth.PermTodoStatusesManage) {
+		return schemas.PostAdminTodoStatuses403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	req := *request.Body
+	label := strings.TrimSpace(req.Status)
+	if err := notBlank("status", label); err != nil {
+		return schemas.PostAdminTodoStatuses400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, err)}, nil
+	}
+
+	// Reload first so the code and the duplicate check see statuses added elsewhere.
+	if err := a.statuses.load(ctx); err != nil {
+		return nil, err
+	}
+	if _, ok := a.statuses.find(label, keyLabel); ok {
+		return schemas.PostAdminTodoStatuses400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, problem.StatusExists, "")}, nil
+	}
+	if req.StatusCode != nil {
+		if _, ok := a.statuses.find(*req.StatusCode, keySlug); ok {
+			return schemas.PostAdminTodoStatuses400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, problem.StatusExists, "")}, nil
+		}
+	}
+	code, ok := a.statuses.nextCode()
+	if !ok {
+		return schemas.PostAdminTodoStatuses400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, problem.TooManyStatuses, "")}, nil
+	}
+
+	s := repo.TodoStatus{Status: code, Label: label}
+	if req.StatusCode != nil {
+		s.Slug = *req.StatusCode
+	}
+	if req.SortOrder != nil {
+		if err := a.statuses.checkSortOrder(ctx, *req.SortOrder); err != nil {
+			return schemas.PostAdminTodoStatuses400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, err)}, nil
+		}
+		s.SortOrder = *req.SortOrder
+	} else if list := a.statuses.all(ctx); len(list) > 0 {
+		s.SortOrder = list[len(list)-1].SortOrder + 10
+	}
+	if req.IsTerminal != nil {
+		s.IsTerminal = *req.IsTerminal
+	}
+	if err := a.repos.Statuses.Create(ctx, s); err != nil {
+		if isMySQLDuplicate(err) {
+			// Raced with another admin on the label, the status_code or the two-digit code.
+			return schemas.PostAdminTodoStatuses400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: badRequest(ctx, problem.StatusExists, "")}, nil
+		}
+		return nil, err
+	}
+	if err := a.statuses.load(ctx); err != nil {
+		slog.WarnContext(ctx, "reload todo statuses", "err", err)
+	}
+	return schemas.PostAdminTodoStatuses201JSONResponse(toTodoStatusInfo(s)), nil
+}
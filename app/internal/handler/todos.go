@@ -1,39 +1,47 @@
 package handler
 
 import (
+	"context"
 	"database/sql"
 	"errors"
+	"net/http"
 	"strings"
 	"time"
 
-	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"go-gin-webapi/internal/auth"
 	"go-gin-webapi/internal/repo"
 	"go-gin-webapi/schemas"
 )
 
-func (a *API) GetUsersUserIdTodos(c *gin.Context, userId schemas.UserId) {
-	if !a.requireSelf(c, string(userId)) {
-		return
+func (a *API) GetUsersUserIdTodos(ctx context.Context, request schemas.GetUsersUserIdTodosRequestObject) (schemas.GetUsersUserIdTodosResponseObject, error) {
+	if !a.requireSelfOr(ctx, string(request.UserId), auth.PermTodosReadAny, auth.ScopeTodosRead) {
+		return schemas.GetUsersUserIdTodos403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
 	}
 
-	todos, err := a.repos.Todos.ListByOwner(c.Request.Context(), string(userId))
+	todos, err := a.repos.Todos.ListByOwner(ctx, string(request.UserId))
 	if err != nil {
-		internalErr(c, err)
-		return
+		return nil, err
 	}
 
+	out, err := a.toTodoListResponse(ctx, todos)
+	if err != nil {
+		return nil, err
+	}
+	return schemas.GetUsersUserIdTodos200JSONResponse(out), nil
+}
+
+func (a *API) toTodoListResponse(ctx context.Context, todos []repo.Todo) (schemas.GetTodoListResponse, error) {
 	out := make(schemas.GetTodoListResponse, 0, len(todos))
 	for _, t := range todos {
 		id := t.ID
 		title := t.Title
-		apiStatus, ok := todoCodeToStatus(t.Status)
+		st, ok := a.statuses.byCode(ctx, t.Status)
 		if !ok {
-			internalErr(c, errors.New("invalid todo status code in db"))
-			return
+			return nil, errors.New("invalid todo status code in db")
 		}
-		status := apiStatus
+		status := st.Label
 
 		var due *schemas.TodoDueDatetime
 		if t.DueDatetime != nil {
@@ -42,195 +50,231 @@ func (a *API) GetUsersUserIdTodos(c *gin.Context, userId schemas.UserId) {
 		}
 		out = append(out, struct {
 			DueDatetime *schemas.TodoDueDatetime `json:"due_datetime,omitempty"`
-			Id          *string                `json:"id,omitempty"`
-			Status      *schemas.TodoStatus    `json:"status,omitempty"`
-			Title       *string                `json:"title,omitempty"`
+			Id          *string                  `json:"id,omitempty"`
+			Status      *schemas.TodoStatus      `json:"status,omitempty"`
+			StatusCode  *schemas.TodoStatusCode  `json:"status_code,omitempty"`
+			Title       *string                  `json:"title,omitempty"`
 		}{
 			DueDatetime: due,
 			Id:          &id,
 			Status:      &status,
+			StatusCode:  slugPtr(st),
 			Title:       &title,
 		})
 	}
-	c.JSON(200, out)
+	return out, nil
 }
 
-func (a *API) PostUsersUserIdTodos(c *gin.Context, userId schemas.UserId) {
-	if !a.requireSelf(c, string(userId)) {
-		return
+func (a *API) PostUsersUserIdTodos(ctx context.Context, request schemas.PostUsersUserIdTodosRequestObject) (schemas.PostUsersUserIdTodosResponseObject, error) {
+	if !a.requireSelf(ctx, string(request.UserId), auth.ScopeTodosWrite) {
+		return schemas.PostUsersUserIdTodos403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
 	}
-	var req schemas.CreateTodoRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		badRequest(c, "invalid json")
-		return
+	owner := string(request.UserId)
+	rules, err := a.repos.Workflows.ListByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
 	}
-	if req.Title == nil || strings.TrimSpace(*req.Title) == "" || req.Content == nil {
-		badRequest(c, "title/content are required")
-		return
+	t, reason, err := a.newTodo(ctx, owner, rules, *request.Body)
+	if err != nil {
+		var te *transitionError
+		switch {
+		case !errors.As(err, &te):
+			return schemas.PostUsersUserIdTodos400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, err)}, nil
+		case te.status == http.StatusConflict:
+			return schemas.PostUsersUserIdTodos409ApplicationProblemPlusJSONResponse{StatusTransitionNotAllowedApplicationProblemPlusJSONResponse: schemas.StatusTransitionNotAllowedApplicationProblemPlusJSONResponse(te.body(ctx))}, nil
+		}
+		return schemas.PostUsersUserIdTodos422ApplicationProblemPlusJSONResponse{StatusReasonRequiredApplicationProblemPlusJSONResponse: schemas.StatusReasonRequiredApplicationProblemPlusJSONResponse(te.body(ctx))}, nil
+	}
+	if err := a.repos.Todos.Create(ctx, t, reason); err != nil {
+		return nil, err
 	}
+	return schemas.PostUsersUserIdTodos201JSONResponse{Id: &t.ID}, nil
+}
 
-	title := strings.TrimSpace(*req.Title)
-	if runeLen(title) > 30 {
-		badRequest(c, "title must be <= 30 chars")
-		return
+// newTodo validates req into a todo of owner with a fresh ID, and returns the reason of its initial status.
+// Errors are client errors: a *transitionError (409/422) when owner's workflow rules out the requested status,
+// 400 otherwise. Lengths are checked by the spec, for the create of a batch too.
+func (a *API) newTodo(ctx context.Context, owner string, rules []repo.TodoTransition, req schemas.CreateTodoRequest) (repo.Todo, string, error) {
+	title := strings.TrimSpace(req.Title)
+	if err := notBlank("title", title); err != nil {
+		return repo.Todo{}, "", err
 	}
-	if runeLen(*req.Content) > 1000 {
-		badRequest(c, "content must be <= 1000 chars")
-		return
+	reason := ""
+	if req.StatusReason != nil {
+		reason = strings.TrimSpace(*req.StatusReason)
 	}
 
-	apiStatus := schemas.TodoStatus("未着手")
-	if req.Status != nil && strings.TrimSpace(string(*req.Status)) != "" {
-		apiStatus = *req.Status
+	initial, hasInitial := a.statuses.initial(ctx)
+	st, ok, err := a.resolveStatus(ctx, req.Status, req.StatusCode)
+	if err != nil {
+		return repo.Todo{}, "", err
 	}
-	statusCode, ok := todoStatusToCode(apiStatus)
 	if !ok {
-		badRequest(c, "status must be one of: 未着手, 進行中, 完了, 保留")
-		return
+		if !hasInitial {
+			return repo.Todo{}, "", a.statuses.invalid(ctx, "status")
+		}
+		st = initial
+	}
+	// Any other status is a change from the initial one, so the workflow's "only from" and reason rules hold
+	// from the start.
+	if hasInitial && st.Status != initial.Status {
+		change := &repo.StatusChange{Status: st.Status, Reason: reason}
+		a.allowByRules(ctx, rules, change)
+		if err := change.Allow(initial.Status); err != nil {
+			var te *transitionError
+			if errors.As(err, &te) {
+				te.allowed = append([]string{initial.Label}, te.allowed...)
+			}
+			return repo.Todo{}, "", err
+		}
 	}
 
 	var due *time.Time
 	if req.DueDatetime != nil && strings.TrimSpace(string(*req.DueDatetime)) != "" {
 		t, err := parseTodoDueDatetime(*req.DueDatetime)
 		if err != nil {
-			badRequest(c, err.Error())
-			return
+			return repo.Todo{}, "", err
 		}
 		due = &t
 	}
 
-	if err := a.repos.Statuses.Ensure(c.Request.Context(), statusCode); err != nil {
-		internalErr(c, err)
-		return
-	}
-
-	id := uuid.NewString()
-	t := repo.Todo{
-		ID:          id,
-		Owner:       string(userId),
-		Status:      statusCode,
+	return repo.Todo{
+		ID:          uuid.NewString(),
+		Owner:       owner,
+		Status:      st.Status,
 		Title:       title,
-		Content:     *req.Content,
+		Content:     req.Content,
 		DueDatetime: due,
-	}
-	if err := a.repos.Todos.Create(c.Request.Context(), t); err != nil {
-		internalErr(c, err)
-		return
-	}
-	c.JSON(201, schemas.CreateTodoResponse{Id: &id})
+	}, reason, nil
 }
 
-func (a *API) GetUsersUserIdTodosTodoId(c *gin.Context, userId schemas.UserId, todoId schemas.TodoId) {
-	if !a.requireSelf(c, string(userId)) {
-		return
+func (a *API) GetUsersUserIdTodosTodoId(ctx context.Context, request schemas.GetUsersUserIdTodosTodoIdRequestObject) (schemas.GetUsersUserIdTodosTodoIdResponseObject, error) {
+	if !a.requireSelfOr(ctx, string(request.UserId), auth.PermTodosReadAny, auth.ScopeTodosRead) {
+		return schemas.GetUsersUserIdTodosTodoId403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
 	}
-	t, err := a.repos.Todos.GetByIDOwner(c.Request.Context(), string(todoId), string(userId))
+	t, err := a.repos.Todos.GetByIDOwner(ctx, string(request.TodoId), string(request.UserId))
 	if err != nil {
 		if err == sql.ErrNoRows {
-			notFound(c)
-			return
+			return schemas.GetUsersUserIdTodosTodoId404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
 		}
-		internalErr(c, err)
-		return
+		return nil, err
 	}
 
-	apiStatus, ok := todoCodeToStatus(t.Status)
+	out, err := a.toTodoDetailResponse(ctx, t.Title, t.Content, t.Status, t.DueDatetime)
+	if err != nil {
+		return nil, err
+	}
+	return schemas.GetUsersUserIdTodosTodoId200JSONResponse(out), nil
+}
+
+func (a *API) toTodoDetailResponse(ctx context.Context, title, content, status string, dueDatetime *time.Time) (schemas.GetTodoDetailResponse, error) {
+	st, ok := a.statuses.byCode(ctx, status)
 	if !ok {
-		internalErr(c, errors.New("invalid todo status code in db"))
-		return
+		return schemas.GetTodoDetailResponse{}, errors.New("invalid todo status code in db")
 	}
 	var due *schemas.TodoDueDatetime
-	if t.DueDatetime != nil {
-		s := formatTodoDueDatetime(*t.DueDatetime)
+	if dueDatetime != nil {
+		s := formatTodoDueDatetime(*dueDatetime)
 		due = &s
 	}
-
-	c.JSON(200, schemas.GetTodoDetailResponse{
-		Title:       &t.Title,
-		Content:     &t.Content,
-		Status:      &apiStatus,
+	return schemas.GetTodoDetailResponse{
+		Title:       &title,
+		Content:     &content,
+		Status:      &st.Label,
+		StatusCode:  slugPtr(st),
 		DueDatetime: due,
-	})
+	}, nil
 }
 
-func (a *API) PutUsersUserIdTodosTodoId(c *gin.Context, userId schemas.UserId, todoId schemas.TodoId) {
-	if !a.requireSelf(c, string(userId)) {
-		return
+func (a *API) PutUsersUserIdTodosTodoId(ctx context.Context, request schemas.PutUsersUserIdTodosTodoIdRequestObject) (schemas.PutUsersUserIdTodosTodoIdResponseObject, error) {
+	if !a.requireSelf(ctx, string(request.UserId), auth.ScopeTodosWrite) {
+		return schemas.PutUsersUserIdTodosTodoId403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	u, err := a.todoUpdate(ctx, *request.Body)
+	if err != nil {
+		return schemas.PutUsersUserIdTodosTodoId400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, err)}, nil
 	}
-	var req schemas.UpdateTodoRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		badRequest(c, "invalid json")
-		return
+	if u.Status != nil {
+		if err := a.applyWorkflow(ctx, string(request.UserId), u.Status); err != nil {
+			return nil, err
+		}
 	}
 
-	var title *string
+	p, _ := auth.PrincipalFrom(ctx)
+	if err := a.repos.Todos.UpdateByIDOwner(ctx, string(request.TodoId), string(request.UserId), p.UID, u); err != nil {
+		var te *transitionError
+		switch {
+		case err == sql.ErrNoRows:
+			return schemas.PutUsersUserIdTodosTodoId404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
+		case !errors.As(err, &te):
+			return nil, err
+		case te.status == http.StatusConflict:
+			return schemas.PutUsersUserIdTodosTodoId409ApplicationProblemPlusJSONResponse{StatusTransitionNotAllowedApplicationProblemPlusJSONResponse: schemas.StatusTransitionNotAllowedApplicationProblemPlusJSONResponse(te.body(ctx))}, nil
+		}
+		return schemas.PutUsersUserIdTodosTodoId422ApplicationProblemPlusJSONResponse{StatusReasonRequiredApplicationProblemPlusJSONResponse: schemas.StatusReasonRequiredApplicationProblemPlusJSONResponse(te.body(ctx))}, nil
+	}
+	id := string(request.TodoId)
+	return schemas.PutUsersUserIdTodosTodoId200JSONResponse{Id: &id}, nil
+}
+
+// todoUpdate validates req. Errors are client errors (400). A status change is returned without its
+// workflow check; see applyWorkflow. The lengths repeat the spec's: values from a JSON Patch are not
+// validated against it.
+func (a *API) todoUpdate(ctx context.Context, req schemas.UpdateTodoRequest) (repo.TodoUpdate, error) {
+	var u repo.TodoUpdate
 	if req.Title != nil {
 		s := strings.TrimSpace(*req.Title)
-		if s == "" {
-			badRequest(c, "title must not be empty")
-			return
+		if err := notBlank("title", s); err != nil {
+			return u, err
 		}
-		if runeLen(s) > 30 {
-			badRequest(c, "title must be <= 30 chars")
-			return
+		if err := maxRunes("title", s, 30); err != nil {
+			return u, err
 		}
-		title = &s
+		u.Title = &s
 	}
 
-	if req.Content != nil && runeLen(*req.Content) > 1000 {
-		badRequest(c, "content must be <= 1000 chars")
-		return
+	if req.Content != nil {
+		if err := maxRunes("content", *req.Content, 1000); err != nil {
+			return u, err
+		}
 	}
+	u.Content = req.Content
 
-	var status *string
-	if req.Status != nil && strings.TrimSpace(string(*req.Status)) != "" {
-		code, ok := todoStatusToCode(*req.Status)
-		if !ok {
-			badRequest(c, "status must be one of: 未着手, 進行中, 完了, 保留")
-			return
-		}
-		if err := a.repos.Statuses.Ensure(c.Request.Context(), code); err != nil {
-			internalErr(c, err)
-			return
+	reason := ""
+	if req.StatusReason != nil {
+		reason = strings.TrimSpace(*req.StatusReason)
+		if err := maxRunes("status_reason", reason, 200); err != nil {
+			return u, err
 		}
-		status = &code
 	}
 
-	var dueDatetime *time.Time
+	st, ok, err := a.resolveStatus(ctx, req.Status, req.StatusCode)
+	if err != nil {
+		return u, err
+	}
+	if ok {
+		u.Status = &repo.StatusChange{Status: st.Status, Reason: reason}
+	}
+
 	if req.DueDatetime != nil && strings.TrimSpace(string(*req.DueDatetime)) != "" {
 		t, err := parseTodoDueDatetime(*req.DueDatetime)
 		if err != nil {
-			badRequest(c, err.Error())
-			return
-		}
-		dueDatetime = &t
-	}
-
-	if err := a.repos.Todos.UpdateByIDOwner(c.Request.Context(), string(todoId), string(userId), title, req.Content, status, dueDatetime); err != nil {
-		if err == sql.ErrNoRows {
-			notFound(c)
-			return
+			return u, err
 		}
-		internalErr(c, err)
-		return
+		u.DueDatetime = &t
 	}
-	id := string(todoId)
-	c.JSON(200, schemas.UpdateTodoResponse{Id: &id})
+	return u, nil
 }
 
-func (a *API) DeleteUsersUserIdTodosTodoId(c *gin.Context, userId schemas.UserId, todoId schemas.TodoId) {
-	if !a.requireSelf(c, string(userId)) {
-		return
+func (a *API) DeleteUsersUserIdTodosTodoId(ctx context.Context, request schemas.DeleteUsersUserIdTodosTodoIdRequestObject) (schemas.DeleteUsersUserIdTodosTodoIdResponseObject, error) {
+	if !a.requireSelf(ctx, string(request.UserId), auth.ScopeTodosWrite) {
+		return schemas.DeleteUsersUserIdTodosTodoId403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
 	}
-	if err := a.repos.Todos.DeleteByIDOwner(c.Request.Context(), string(todoId), string(userId)); err != nil {
+	if err := a.repos.Todos.DeleteByIDOwner(ctx, string(request.TodoId), string(request.UserId)); err != nil {
 		if err == sql.ErrNoRows {
-			notFound(c)
-			return
+			return schemas.DeleteUsersUserIdTodosTodoId404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
 		}
-		internalErr(c, err)
-		return
+		return nil, err
 	}
-	c.Status(204)
+	return schemas.DeleteUsersUserIdTodosTodoId204Response{}, nil
 }
-
-
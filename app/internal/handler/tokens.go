@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go-gin-webapi/internal/auth"
+	"go-gin-webapi/internal/repo"
+	"go-gin-webapi/schemas"
+)
+
+func (a *API) GetUsersUserIdTokens(ctx context.Context, request schemas.GetUsersUserIdTokensRequestObject) (schemas.GetUsersUserIdTokensResponseObject, error) {
+	if !a.requireSelf(ctx, string(request.UserId), auth.ScopeAll) {
+		return schemas.GetUsersUserIdTokens403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	tokens, err := a.repos.Tokens.ListByOwner(ctx, string(request.UserId))
+	if err != nil {
+		return nil, err
+	}
+	out := make(schemas.AccessTokenListResponse, 0, len(tokens))
+	for _, t := range tokens {
+		out = append(out, toAccessTokenResponse(t))
+	}
+	return schemas.GetUsersUserIdTokens200JSONResponse(out), nil
+}
+
+func (a *API) PostUsersUserIdTokens(ctx context.Context, request schemas.PostUsersUserIdTokensRequestObject) (schemas.PostUsersUserIdTokensResponseObject, error) {
+	if !a.requireSelf(ctx, string(request.UserId), auth.ScopeAll) {
+		return schemas.PostUsersUserIdTokens403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	req := *request.Body
+	// Lengths and scope names are checked by the spec.
+	name := strings.TrimSpace(req.Name)
+	if err := notBlank("name", name); err != nil {
+		return schemas.PostUsersUserIdTokens400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, err)}, nil
+	}
+	var scopes []string
+	for _, s := range req.Scopes {
+		if !slices.Contains(scopes, string(s)) {
+			scopes = append(scopes, string(s))
+		}
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return schemas.PostUsersUserIdTokens400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalidField(ctx, "expires_at", "future", nil)}, nil
+	}
+
+	secret, hash, err := auth.NewAccessToken()
+	if err != nil {
+		return nil, err
+	}
+	t := repo.AccessToken{
+		ID:        uuid.NewString(),
+		Owner:     string(request.UserId),
+		Name:      name,
+		TokenHash: hash,
+		Scopes:    scopes,
+		ExpiresAt: req.ExpiresAt,
+	}
+	if err := a.repos.Tokens.Create(ctx, t); err != nil {
+		return nil, err
+	}
+	created, err := a.repos.Tokens.GetByIDOwner(ctx, t.ID, t.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	res := toAccessTokenResponse(created)
+	return schemas.PostUsersUserIdTokens201JSONResponse{
+		Id:        res.Id,
+		Name:      res.Name,
+		Scopes:    res.Scopes,
+		ExpiresAt: res.ExpiresAt,
+		CreatedAt: res.CreatedAt,
+		Token:     &secret,
+	}, nil
+}
+
+func (a *API) DeleteUsersUserIdTokensTokenId(ctx context.Context, request schemas.DeleteUsersUserIdTokensTokenIdRequestObject) (schemas.DeleteUsersUserIdTokensTokenIdResponseObject, error) {
+	if !a.requireSelf(ctx, string(request.UserId), auth.ScopeAll) {
+		return schemas.DeleteUsersUserIdTokensTokenId403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	if err := a.repos.Tokens.DeleteByIDOwner(ctx, string(request.TokenId), string(request.UserId)); err != nil {
+		if err == sql.ErrNoRows {
+			return schemas.DeleteUsersUserIdTokensTokenId404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
+		}
+		return nil, err
+	}
+	return schemas.DeleteUsersUserIdTokensTokenId204Response{}, nil
+}
+
+func toAccessTokenResponse(t repo.AccessToken) schemas.AccessToken {
+	id := t.ID
+	name := t.Name
+	createdAt := t.CreatedAt
+	scopes := make([]schemas.AccessTokenScope, 0, len(t.Scopes))
+	for _, s := range t.Scopes {
+		scopes = append(scopes, schemas.AccessTokenScope(s))
+	}
+	return schemas.AccessToken{
+		Id:         &id,
+		Name:       &name,
+		Scopes:     &scopes,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+		CreatedAt:  &createdAt,
+	}
+}
@@ -1,80 +1,84 @@
 package handler
 
 import (
+	"context"
 	"database/sql"
 	"strings"
 
-	"github.com/gin-gonic/gin"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 
+	"go-gin-webapi/internal/auth"
 	"go-gin-webapi/schemas"
 )
 
-func (a *API) GetUsersUserId(c *gin.Context, userId schemas.UserId) {
-	if !a.requireSelf(c, string(userId)) {
-		return
+func (a *API) GetUsersUserId(ctx context.Context, request schemas.GetUsersUserIdRequestObject) (schemas.GetUsersUserIdResponseObject, error) {
+	if !a.requireSelf(ctx, string(request.UserId), auth.ScopeAll) {
+		return schemas.GetUsersUserId403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
 	}
-	u, err := a.repos.Users.GetByUID(c.Request.Context(), string(userId))
+	u, err := a.repos.Users.GetByUID(ctx, string(request.UserId))
 	if err != nil {
 		if err == sql.ErrNoRows {
-			notFound(c)
-			return
+			return schemas.GetUsersUserId404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
 		}
-		internalErr(c, err)
-		return
+		return nil, err
 	}
 
-	email := openapi_types.Email(u.Email)
-	c.JSON(200, schemas.GetUserDetailResponse{
-		Nickname: &u.Nickname,
-		Email:    &email,
-	})
+	return schemas.GetUsersUserId200JSONResponse{
+		Nickname:    &u.Nickname,
+		Email:       emailPtr(u.Email),
+		IsAnonymous: &u.IsAnonymous,
+	}, nil
 }
 
-func (a *API) PutUsersUserId(c *gin.Context, userId schemas.UserId) {
-	if !a.requireSelf(c, string(userId)) {
-		return
+func (a *API) PutUsersUserId(ctx context.Context, request schemas.PutUsersUserIdRequestObject) (schemas.PutUsersUserIdResponseObject, error) {
+	if !a.requireSelf(ctx, string(request.UserId), auth.ScopeAll) {
+		return schemas.PutUsersUserId403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
 	}
-	var req schemas.UpdateUserRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		badRequest(c, "invalid json")
-		return
+	nickname, email, err := userUpdate(*request.Body)
+	if err != nil {
+		return schemas.PutUsersUserId400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, err)}, nil
 	}
 
+	u, err := a.repos.Users.Update(ctx, string(request.UserId), nickname, email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return schemas.PutUsersUserId404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: notFound(ctx)}, nil
+		}
+		return nil, err
+	}
+
+	return schemas.PutUsersUserId200JSONResponse{
+		Nickname: &u.Nickname,
+		Email:    emailPtr(u.Email),
+	}, nil
+}
+
+// userUpdate validates req into the arguments of UserRepo.Update. Errors are client errors (400).
+func userUpdate(req schemas.UpdateUserRequest) (nickname, email *string, err error) {
 	if req.Nickname != nil {
 		n := strings.TrimSpace(*req.Nickname)
-		if n == "" {
-			badRequest(c, "nickname must not be empty")
-			return
+		if err := notBlank("nickname", n); err != nil {
+			return nil, nil, err
 		}
-		if runeLen(n) > 20 {
-			badRequest(c, "nickname must be <= 20 chars")
-			return
+		if err := maxRunes("nickname", n, 20); err != nil {
+			return nil, nil, err
 		}
-		req.Nickname = &n
+		nickname = &n
 	}
-
-	var emailStr *string
 	if req.Email != nil {
 		s := string(*req.Email)
-		emailStr = &s
+		email = &s
 	}
+	return nickname, email, nil
+}
 
-	u, err := a.repos.Users.Update(c.Request.Context(), string(userId), req.Nickname, emailStr)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			notFound(c)
-			return
-		}
-		internalErr(c, err)
-		return
+// emailPtr omits the email of anonymous users.
+func emailPtr(s string) *openapi_types.Email {
+	if s == "" {
+		return nil
 	}
-
-	email := openapi_types.Email(u.Email)
-	c.JSON(200, schemas.UpdateUserResponse{
-		Nickname: &u.Nickname,
-		Email:    &email,
-	})
+	e := openapi_types.Email(s)
+	return &e
 }
 
 
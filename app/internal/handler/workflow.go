@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go-gin-webapi/internal/auth"
+	"go-gin-webapi/internal/i18n"
+	"go-gin-webapi/internal/problem"
+	"go-gin-webapi/internal/repo"
+	"go-gin-webapi/schemas"
+)
+
+// transitionError rejects a status change: 409 when the transition is not allowed, 422 when it needs a reason.
+type transitionError struct {
+	status  int
+	detail  i18n.Message
+	allowed []string // labels reachable from the current status
+}
+
+func (e *transitionError) Error() string { return e.detail.Error() }
+
+func (e *transitionError) code() string {
+	if e.status == http.StatusConflict {
+		return problem.TransitionNotAllowed
+	}
+	return problem.StatusReasonRequired
+}
+
+func (e *transitionError) body(ctx context.Context) schemas.StatusTransitionError {
+	body := problemOnly(problem.New(ctx, e.status, e.code(), e.detail.In(ctx)))
+	allowed := append([]schemas.TodoStatus{}, e.allowed...)
+	body.AllowedStatuses = &allowed
+	return body
+}
+
+// applyWorkflow makes change subject to owner's workflow, checked against the todo's status at update time.
+func (a *API) applyWorkflow(ctx context.Context, owner string, change *repo.StatusChange) error {
+	rules, err := a.repos.Workflows.ListByOwner(ctx, owner)
+	if err != nil {
+		return err
+	}
+	a.allowByRules(ctx, rules, change)
+	return nil
+}
+
+// allowByRules is applyWorkflow with rules already loaded.
+func (a *API) allowByRules(ctx context.Context, rules []repo.TodoTransition, change *repo.StatusChange) {
+	to, reason := change.Status, change.Reason
+	change.Allow = func(from string) error {
+		return a.checkTransition(ctx, rules, from, to, reason)
+	}
+}
+
+// checkTransition applies owner's rules (codes) to a change from -> to. No rules means anything goes.
+func (a *API) checkTransition(ctx context.Context, rules []repo.TodoTransition, from, to, reason string) error {
+	if len(rules) == 0 {
+		return nil
+	}
+	var (
+		next  []repo.TodoStatus
+		match *repo.TodoTransition
+	)
+	for i, r := range rules {
+		if r.From != from {
+			continue
+		}
+		if r.To == to {
+			match = &rules[i]
+		}
+		if st, ok := a.statuses.byCode(ctx, r.To); ok {
+			next = append(next, st)
+		}
+	}
+	sort.Slice(next, func(i, j int) bool { return next[i].SortOrder < next[j].SortOrder })
+	allowed := make([]string, 0, len(next))
+	for _, st := range next {
+		allowed = append(allowed, st.Label)
+	}
+
+	if match == nil {
+		return &transitionError{
+			status:  http.StatusConflict,
+			detail:  i18n.Msg("detail.transition_not_allowed", a.statusLabel(ctx, from), a.statusLabel(ctx, to)),
+			allowed: allowed,
+		}
+	}
+	if match.RequiresReason && reason == "" {
+		return &transitionError{
+			status:  http.StatusUnprocessableEntity,
+			detail:  i18n.Msg("detail.status_reason_required", a.statusLabel(ctx, to)),
+			allowed: allowed,
+		}
+	}
+	return nil
+}
+
+func (a *API) statusLabel(ctx context.Context, code string) string {
+	if st, ok := a.statuses.byCode(ctx, code); ok {
+		return st.Label
+	}
+	return code
+}
+
+func (a *API) toTodoWorkflow(ctx context.Context, rules []repo.TodoTransition) schemas.TodoWorkflow {
+	out := make([]schemas.TodoStatusTransition, 0, len(rules))
+	for _, r := range rules {
+		from := a.statusLabel(ctx, r.From)
+		to := a.statusLabel(ctx, r.To)
+		requiresReason := r.RequiresReason
+		out = append(out, schemas.TodoStatusTransition{From: &from, To: &to, RequiresReason: &requiresReason})
+	}
+	return schemas.TodoWorkflow{Transitions: &out}
+}
+
+func (a *API) GetUsersUserIdWorkflow(ctx context.Context, request schemas.GetUsersUserIdWorkflowRequestObject) (schemas.GetUsersUserIdWorkflowResponseObject, error) {
+	if !a.requireSelf(ctx, string(request.UserId), auth.ScopeTodosRead) {
+		return schemas.GetUsersUserIdWorkflow403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	rules, err := a.repos.Workflows.ListByOwner(ctx, string(request.UserId))
+	if err != nil {
+		return nil, err
+	}
+	return schemas.GetUsersUserIdWorkflow200JSONResponse(a.toTodoWorkflow(ctx, rules)), nil
+}
+
+func (a *API) PutUsersUserIdWorkflow(ctx context.Context, request schemas.PutUsersUserIdWorkflowRequestObject) (schemas.PutUsersUserIdWorkflowResponseObject, error) {
+	if !a.requireSelf(ctx, string(request.UserId), auth.ScopeTodosWrite) {
+		return schemas.PutUsersUserIdWorkflow403ApplicationProblemPlusJSONResponse{ForbiddenApplicationProblemPlusJSONResponse: forbidden(ctx)}, nil
+	}
+	req := *request.Body
+
+	var rules []repo.TodoTransition
+	if req.Transitions != nil {
+		seen := make(map[[2]string]bool, len(*req.Transitions))
+		for i, t := range *req.Transitions {
+			field := "transitions." + strconv.Itoa(i)
+			if t.From == nil {
+				return schemas.PutUsersUserIdWorkflow400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalidField(ctx, field+".from", "required", nil)}, nil
+			}
+			if t.To == nil {
+				return schemas.PutUsersUserIdWorkflow400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalidField(ctx, field+".to", "required", nil)}, nil
+			}
+			from, ok := a.statuses.byLabel(ctx, strings.TrimSpace(*t.From))
+			if !ok {
+				return schemas.PutUsersUserIdWorkflow400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, a.statuses.invalid(ctx, field+".from"))}, nil
+			}
+			to, ok := a.statuses.byLabel(ctx, strings.TrimSpace(*t.To))
+			if !ok {
+				return schemas.PutUsersUserIdWorkflow400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalid(ctx, a.statuses.invalid(ctx, field+".to"))}, nil
+			}
+			if from.Status == to.Status {
+				return schemas.PutUsersUserIdWorkflow400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalidField(ctx, field, "not_self", nil)}, nil
+			}
+			key := [2]string{from.Status, to.Status}
+			if seen[key] {
+				return schemas.PutUsersUserIdWorkflow400ApplicationProblemPlusJSONResponse{BadRequestApplicationProblemPlusJSONResponse: invalidField(ctx, field, "unique", nil)}, nil
+			}
+			seen[key] = true
+			rules = append(rules, repo.TodoTransition{
+				From:           from.Status,
+				To:             to.Status,
+				RequiresReason: t.RequiresReason != nil && *t.RequiresReason,
+			})
+		}
+	}
+
+	if err := a.repos.Workflows.ReplaceByOwner(ctx, string(request.UserId), rules); err != nil {
+		return nil, err
+	}
+	return schemas.PutUsersUserIdWorkflow200JSONResponse(a.toTodoWorkflow(ctx, rules)), nil
+}
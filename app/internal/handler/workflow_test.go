@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"go-gin-webapi/internal/repo"
+	"go-gin-webapi/schemas"
+)
+
+// testAPI is an API whose status catalog holds the default statuses and is fresh, so it never
+// reaches for the database.
+func testAPI() *API {
+	list := []repo.TodoStatus{
+		{Status: "00", Label: "未着手", Slug: "not_started", SortOrder: 0},
+		{Status: "01", Label: "進行中", Slug: "in_progress", SortOrder: 1},
+		{Status: "02", Label: "完了", Slug: "done", SortOrder: 2, IsTerminal: true},
+		{Status: "03", Label: "保留", Slug: "on_hold", SortOrder: 3},
+	}
+	c := &todoStatusCatalog{
+		list:     list,
+		codes:    map[string]repo.TodoStatus{},
+		labels:   map[string]repo.TodoStatus{},
+		slugs:    map[string]repo.TodoStatus{},
+		loadedAt: time.Now(),
+	}
+	for _, s := range list {
+		c.codes[s.Status], c.labels[s.Label], c.slugs[s.Slug] = s, s, s
+	}
+	return &API{statuses: c}
+}
+
+// testRules: 未着手 -> 進行中 -> 完了 (with a reason), 進行中 <-> 保留.
+var testRules = []repo.TodoTransition{
+	{From: "00", To: "01"},
+	{From: "01", To: "02", RequiresReason: true},
+	{From: "01", To: "03"},
+	{From: "03", To: "01"},
+}
+
+func TestCheckTransition(t *testing.T) {
+	a := testAPI()
+	tests := []struct {
+		name        string
+		rules       []repo.TodoTransition
+		from, to    string
+		reason      string
+		wantStatus  int // 0: allowed
+		wantAllowed []string
+	}{
+		{name: "no rules", from: "02", to: "00"},
+		{name: "allowed", rules: testRules, from: "00", to: "01"},
+		{name: "reason given", rules: testRules, from: "01", to: "02", reason: "shipped"},
+		{
+			name: "reason missing", rules: testRules, from: "01", to: "02",
+			wantStatus: http.StatusUnprocessableEntity, wantAllowed: []string{"完了", "保留"},
+		},
+		{
+			name: "not allowed", rules: testRules, from: "00", to: "02", reason: "skip",
+			wantStatus: http.StatusConflict, wantAllowed: []string{"進行中"},
+		},
+		{
+			name: "terminal", rules: testRules, from: "02", to: "01",
+			wantStatus: http.StatusConflict, wantAllowed: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change := &repo.StatusChange{Status: tt.to, Reason: tt.reason}
+			a.allowByRules(context.Background(), tt.rules, change)
+			err := change.Allow(tt.from)
+			if tt.wantStatus == 0 {
+				if err != nil {
+					t.Fatalf("Allow(%s -> %s) = %v, want nil", tt.from, tt.to, err)
+				}
+				return
+			}
+			var te *transitionError
+			if !errors.As(err, &te) {
+				t.Fatalf("Allow(%s -> %s) = %v, want a transitionError", tt.from, tt.to, err)
+			}
+			if te.status != tt.wantStatus || !reflect.DeepEqual(te.allowed, tt.wantAllowed) {
+				t.Errorf("Allow(%s -> %s) = %d %v, want %d %v", tt.from, tt.to, te.status, te.allowed, tt.wantStatus, tt.wantAllowed)
+			}
+		})
+	}
+}
+
+func TestNewTodoStatus(t *testing.T) {
+	a := testAPI()
+	ptr := func(s string) *string { return &s }
+	tests := []struct {
+		name        string
+		rules       []repo.TodoTransition
+		req         schemas.CreateTodoRequest
+		wantStatus  string
+		wantReason  string
+		wantErr     int // 0: created
+		wantAllowed []string
+	}{
+		{name: "default", rules: testRules, req: schemas.CreateTodoRequest{Title: "a"}, wantStatus: "00"},
+		{name: "initial given", rules: testRules, req: schemas.CreateTodoRequest{Title: "a", Status: ptr("未着手")}, wantStatus: "00"},
+		{name: "no rules", req: schemas.CreateTodoRequest{Title: "a", Status: ptr("完了")}, wantStatus: "02"},
+		{name: "reachable from the initial status", rules: testRules, req: schemas.CreateTodoRequest{Title: "a", StatusCode: ptr("in_progress")}, wantStatus: "01"},
+		{
+			name: "reason kept", req: schemas.CreateTodoRequest{Title: "a", Status: ptr("保留"), StatusReason: ptr(" waiting ")},
+			wantStatus: "03", wantReason: "waiting",
+		},
+		{
+			name: "not reachable", rules: testRules, req: schemas.CreateTodoRequest{Title: "a", Status: ptr("完了")},
+			wantErr: http.StatusConflict, wantAllowed: []string{"未着手", "進行中"},
+		},
+		{
+			name:  "reason required",
+			rules: []repo.TodoTransition{{From: "00", To: "03", RequiresReason: true}},
+			req:   schemas.CreateTodoRequest{Title: "a", Status: ptr("保留"), StatusReason: ptr("  ")},
+			// The workflow allows 保留 from 未着手, and creating as 未着手 is always possible.
+			wantErr: http.StatusUnprocessableEntity, wantAllowed: []string{"未着手", "保留"},
+		},
+		{name: "unknown status", rules: testRules, req: schemas.CreateTodoRequest{Title: "a", Status: ptr("?")}, wantErr: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todo, reason, err := a.newTodo(context.Background(), "alice", tt.rules, tt.req)
+			if tt.wantErr == 0 {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if todo.Status != tt.wantStatus || reason != tt.wantReason || todo.Owner != "alice" {
+					t.Errorf("todo = %s %q (owner %s), want %s %q", todo.Status, reason, todo.Owner, tt.wantStatus, tt.wantReason)
+				}
+				return
+			}
+			var te *transitionError
+			if !errors.As(err, &te) {
+				if err == nil || tt.wantErr != http.StatusBadRequest {
+					t.Fatalf("err = %v, want status %d", err, tt.wantErr)
+				}
+				return
+			}
+			if te.status != tt.wantErr || !reflect.DeepEqual(te.allowed, tt.wantAllowed) {
+				t.Errorf("err = %d %v, want %d %v", te.status, te.allowed, tt.wantErr, tt.wantAllowed)
+			}
+		})
+	}
+}
+
+func TestCheckSortOrder(t *testing.T) {
+	a := testAPI()
+	tests := []struct {
+		name    string
+		order   int
+		wantErr bool
+	}{
+		{name: "before the initial status", order: -1, wantErr: true},
+		{name: "same as the initial status", order: 0, wantErr: true},
+		{name: "after the initial status", order: 1},
+		{name: "last", order: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := a.statuses.checkSortOrder(context.Background(), tt.order)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("checkSortOrder(%d) = %v, want nil", tt.order, err)
+				}
+				return
+			}
+			var fe *fieldError
+			if !errors.As(err, &fe) || fe.field != "sort_order" || fe.rule != "minimum" || fe.param != 1 {
+				t.Fatalf("checkSortOrder(%d) = %v, want sort_order minimum 1", tt.order, err)
+			}
+		})
+	}
+}
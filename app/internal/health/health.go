@@ -0,0 +1,97 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrDraining is reported by the shutdown check once the server started draining.
+var ErrDraining = errors.New("server is shutting down")
+
+// Check is one readiness dependency. It should honor ctx, which carries the per-check timeout.
+type Check struct {
+	Name string
+	Fn   func(ctx context.Context) error
+}
+
+// Checker serves /healthz and /readyz.
+type Checker struct {
+	checks   []Check
+	timeout  time.Duration
+	draining atomic.Bool
+}
+
+// New builds a Checker running checks (each bounded by timeout) plus a built-in "shutdown" check.
+func New(timeout time.Duration, checks ...Check) *Checker {
+	h := &Checker{timeout: timeout}
+	h.checks = append([]Check{{Name: "shutdown", Fn: h.checkDraining}}, checks...)
+	return h
+}
+
+// Drain makes readiness fail so load balancers stop routing here before the server shuts down.
+func (h *Checker) Drain() {
+	h.draining.Store(true)
+}
+
+func (h *Checker) checkDraining(context.Context) error {
+	if h.draining.Load() {
+		return ErrDraining
+	}
+	return nil
+}
+
+type readiness struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+// Liveness reports that the process is up and serving; it never touches dependencies.
+func (h *Checker) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Readiness runs every check concurrently and returns 503 with the per-dependency breakdown (ok or fail)
+// if any failed. The errors may name hosts or credentials, so they are only logged.
+func (h *Checker) Readiness(c *gin.Context) {
+	out := readiness{Status: "ok", Checks: make(map[string]string, len(h.checks))}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, chk := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+			defer cancel()
+			res := "ok"
+			if err := chk.Fn(ctx); err != nil {
+				res = "fail"
+				if !errors.Is(err, ErrDraining) {
+					slog.WarnContext(c.Request.Context(), "readiness check failed", "check", chk.Name, "err", err)
+				}
+			}
+			mu.Lock()
+			out.Checks[chk.Name] = res
+			if res != "ok" {
+				out.Status = "fail"
+			}
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	status := http.StatusOK
+	if out.Status != "ok" {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, out)
+}
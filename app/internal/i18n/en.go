@@ -0,0 +1,63 @@
+package i18n
+
+// en is the reference catalog: every key is defined here. Keys are grouped by prefix: problem.<code> is
+// the title of a problem code, detail.* a problem's detail, rule.<rule> a field error and batch.* the error
+// of a batch operation.
+var en = map[string]string{
+	"problem.validation_failed":             "Validation failed",
+	"problem.invalid_json":                  "Malformed request body",
+	"problem.invalid_patch":                 "Invalid patch",
+	"problem.email_taken":                   "Email already in use",
+	"problem.invalid_credentials":           "Invalid email or password",
+	"problem.identity_rejected":             "Rejected by the identity provider",
+	"problem.not_registered":                "User is not registered",
+	"problem.already_registered":            "User is already registered",
+	"problem.status_exists":                 "Status already exists",
+	"problem.too_many_statuses":             "Too many statuses",
+	"problem.unauthorized":                  "Unauthorized",
+	"problem.forbidden":                     "Forbidden",
+	"problem.not_found":                     "Not found",
+	"problem.patch_test_failed":             "JSON Patch test failed",
+	"problem.status_transition_not_allowed": "Status transition not allowed",
+	"problem.unsupported_media_type":        "Unsupported media type",
+	"problem.status_reason_required":        "Status reason required",
+	"problem.rate_limited":                  "Too many requests",
+	"problem.internal_error":                "Internal server error",
+
+	"detail.invalid_fields":         "the request has invalid fields",
+	"detail.invalid_json":           "the request body could not be decoded",
+	"detail.content_type":           "content type must be one of %s",
+	"detail.invalid_merge_patch":    "invalid merge patch",
+	"detail.invalid_json_patch":     "invalid json patch",
+	"detail.json_patch_failed":      "json patch could not be applied: %s",
+	"detail.json_patch_test_failed": "json patch test failed",
+	"detail.patched_not_object":     "patched document must be an object",
+	"detail.transition_not_allowed": "status transition from %s to %s is not allowed",
+	"detail.status_reason_required": "status_reason is required to move to %s",
+	"detail.register_first":         "register before logging in",
+	"detail.refresh_token_rejected": "the refresh token is expired or revoked; log in again",
+
+	"rule.required":             "value is required",
+	"rule.not_blank":            "must not be blank",
+	"rule.minLength":            "minimum string length is %s",
+	"rule.maxLength":            "maximum string length is %s",
+	"rule.minItems":             "minimum number of items is %s",
+	"rule.maxItems":             "maximum number of items is %s",
+	"rule.minimum":              "number must be at least %s",
+	"rule.maximum":              "number must be at most %s",
+	"rule.enum":                 "must be one of: %s",
+	"rule.pattern":              "must match the pattern %s",
+	"rule.format":               "must be in the format %s",
+	"rule.type":                 "must be of type %s",
+	"rule.additionalProperties": "unknown field",
+	"rule.match_path":           "must match the path",
+	"rule.match_status":         "must name the same status as status",
+	"rule.future":               "must be in the future",
+	"rule.not_self":             "a transition to the same status is not needed",
+	"rule.unique":               "must not be duplicated",
+	"rule.strength":             "password is too weak",
+
+	"batch.not_found":   "not found",
+	"batch.rolled_back": "rolled back",
+	"batch.internal":    "internal server error",
+}
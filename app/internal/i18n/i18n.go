@@ -0,0 +1,122 @@
+// Package i18n holds the message catalogs of the API's error responses and picks one per request
+// from Accept-Language.
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
+)
+
+// Supported languages. English is the default: it is what clients got before the catalogs existed.
+const (
+	English  = "en"
+	Japanese = "ja"
+)
+
+var catalogs = map[string]map[string]string{
+	English:  en,
+	Japanese: ja,
+}
+
+// matcher's first tag is the fallback for an absent or unsupported Accept-Language.
+var matcher = language.NewMatcher([]language.Tag{language.English, language.Japanese})
+
+type ctxKey struct{}
+
+// Middleware negotiates the response language from Accept-Language and stores it in the request context.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := Negotiate(c.GetHeader("Accept-Language"))
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxKey{}, lang))
+		c.Header("Content-Language", lang)
+		c.Writer.Header().Add("Vary", "Accept-Language")
+		c.Next()
+	}
+}
+
+// Negotiate returns the supported language that best matches an Accept-Language header.
+func Negotiate(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return English
+	}
+	_, i, _ := matcher.Match(tags...)
+	if i == 1 {
+		return Japanese
+	}
+	return English
+}
+
+// Language returns the language of ctx, English outside a request.
+func Language(ctx context.Context) string {
+	if ctx != nil {
+		if lang, ok := ctx.Value(ctxKey{}).(string); ok {
+			return lang
+		}
+	}
+	return English
+}
+
+// T renders the message key in the language of ctx. A key missing from that catalog falls back to English,
+// and a key missing from both to the key itself.
+func T(ctx context.Context, key string, args ...any) string {
+	format, ok := catalogs[Language(ctx)][key]
+	if !ok {
+		if format, ok = en[key]; !ok {
+			return key
+		}
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// Message is a message key with its arguments, rendered when the request's language is known.
+type Message struct {
+	Key  string
+	Args []any
+}
+
+func Msg(key string, args ...any) Message { return Message{Key: key, Args: args} }
+
+// In renders m in the language of ctx.
+func (m Message) In(ctx context.Context) string { return T(ctx, m.Key, m.Args...) }
+
+// Error renders m in English, so a Message can be returned and logged as an error.
+func (m Message) Error() string { return T(context.Background(), m.Key, m.Args...) }
+
+// Field renders the message of a failed validation rule (maxLength, enum, ...). param is the rule's value
+// in the schema, e.g. 30 for maxLength or the allowed values for enum. ok is false for a rule without a message.
+func Field(ctx context.Context, rule string, param any) (msg string, ok bool) {
+	key := "rule." + rule
+	format, ok := en[key]
+	if !ok {
+		return "", false
+	}
+	if param == nil {
+		if strings.Contains(format, "%") {
+			return "", false
+		}
+		return T(ctx, key), true
+	}
+	return T(ctx, key, formatParam(param)), true
+}
+
+func formatParam(param any) string {
+	switch p := param.(type) {
+	case []string:
+		return strings.Join(p, ", ")
+	case []any:
+		s := make([]string, 0, len(p))
+		for _, v := range p {
+			s = append(s, fmt.Sprint(v))
+		}
+		return strings.Join(s, ", ")
+	}
+	return fmt.Sprint(param)
+}
@@ -0,0 +1,60 @@
+package i18n
+
+var ja = map[string]string{
+	"problem.validation_failed":             "入力内容に誤りがあります",
+	"problem.invalid_json":                  "リクエストボディを解釈できません",
+	"problem.invalid_patch":                 "パッチが不正です",
+	"problem.email_taken":                   "メールアドレスは既に使われています",
+	"problem.invalid_credentials":           "メールアドレスまたはパスワードが違います",
+	"problem.identity_rejected":             "認証基盤に拒否されました",
+	"problem.not_registered":                "ユーザー登録されていません",
+	"problem.already_registered":            "既にユーザー登録されています",
+	"problem.status_exists":                 "ステータスは既に存在します",
+	"problem.too_many_statuses":             "ステータスが多すぎます",
+	"problem.unauthorized":                  "認証が必要です",
+	"problem.forbidden":                     "権限がありません",
+	"problem.not_found":                     "見つかりません",
+	"problem.patch_test_failed":             "JSON Patch の test が失敗しました",
+	"problem.status_transition_not_allowed": "このステータスには変更できません",
+	"problem.unsupported_media_type":        "対応していない Content-Type です",
+	"problem.status_reason_required":        "ステータス変更の理由が必要です",
+	"problem.rate_limited":                  "リクエストが多すぎます",
+	"problem.internal_error":                "サーバーエラーが発生しました",
+
+	"detail.invalid_fields":         "入力内容に誤りがあります（errors を参照）",
+	"detail.invalid_json":           "リクエストボディを JSON として解釈できません",
+	"detail.content_type":           "Content-Type は %s のいずれかにしてください",
+	"detail.invalid_merge_patch":    "マージパッチが不正です",
+	"detail.invalid_json_patch":     "JSON Patch が不正です",
+	"detail.json_patch_failed":      "JSON Patch を適用できません: %s",
+	"detail.json_patch_test_failed": "JSON Patch の test 操作が失敗しました",
+	"detail.patched_not_object":     "パッチ適用後のドキュメントがオブジェクトではありません",
+	"detail.transition_not_allowed": "%s から %s への変更は許可されていません",
+	"detail.status_reason_required": "%s に変更するには status_reason が必要です",
+	"detail.register_first":         "ログインの前にユーザー登録してください",
+	"detail.refresh_token_rejected": "refresh_token が失効しています。再度ログインしてください",
+
+	"rule.required":             "必須です",
+	"rule.not_blank":            "空白以外の文字を入力してください",
+	"rule.minLength":            "%s 文字以上で入力してください",
+	"rule.maxLength":            "%s 文字以内で入力してください",
+	"rule.minItems":             "%s 件以上指定してください",
+	"rule.maxItems":             "%s 件以内で指定してください",
+	"rule.minimum":              "%s 以上の値を指定してください",
+	"rule.maximum":              "%s 以下の値を指定してください",
+	"rule.enum":                 "次のいずれかを指定してください: %s",
+	"rule.pattern":              "パターン %s に一致する値を指定してください",
+	"rule.format":               "%s の形式で指定してください",
+	"rule.type":                 "%s 型で指定してください",
+	"rule.additionalProperties": "不明な項目です",
+	"rule.match_path":           "パスの値と一致させてください",
+	"rule.match_status":         "status と同じステータスを指定してください",
+	"rule.future":               "未来の日時を指定してください",
+	"rule.not_self":             "同じステータスへの遷移は指定できません",
+	"rule.unique":               "重複しています",
+	"rule.strength":             "パスワードが弱すぎます",
+
+	"batch.not_found":   "見つかりません",
+	"batch.rolled_back": "ロールバックされました",
+	"batch.internal":    "サーバーエラーが発生しました",
+}
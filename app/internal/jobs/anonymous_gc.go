@@ -0,0 +1,96 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	fbauth "firebase.google.com/go/v4/auth"
+
+	"go-gin-webapi/internal/auth"
+	"go-gin-webapi/internal/repo"
+)
+
+// gcBatchSize bounds how many accounts one query of a sweep lists.
+const gcBatchSize = 100
+
+// errNoFirebaseAdmin stops a sweep that could only delete the users rows, leaving the Firebase users alive.
+var errNoFirebaseAdmin = errors.New("firebase admin is not configured; skipping the sweep")
+
+// AnonymousGC deletes anonymous accounts (Firebase user and users row, todos cascade) that stayed inactive for MaxIdle.
+type AnonymousGC struct {
+	Users    *repo.UserRepo
+	Firebase *auth.FirebaseAdmin
+	// Bypass is auth.bypass: accounts only exist in the DB, so no Firebase Admin is needed.
+	Bypass   bool
+	MaxIdle  time.Duration
+	Interval time.Duration
+}
+
+// Run sweeps every Interval until ctx is done.
+func (g *AnonymousGC) Run(ctx context.Context) {
+	t := time.NewTicker(g.Interval)
+	defer t.Stop()
+	for {
+		if err := g.Sweep(ctx); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "anonymous gc sweep failed", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Sweep deletes idle anonymous accounts in batches of gcBatchSize until none are left or ctx is done.
+// Without Firebase Admin (and outside bypass) it deletes nothing and returns an error.
+func (g *AnonymousGC) Sweep(ctx context.Context) error {
+	hasFirebase := g.Firebase != nil && g.Firebase.Auth != nil
+	if !hasFirebase && !g.Bypass {
+		return errNoFirebaseAdmin
+	}
+	cutoff := time.Now().Add(-g.MaxIdle)
+	total := 0
+	defer func() {
+		if total > 0 {
+			slog.InfoContext(ctx, "anonymous gc: deleted idle anonymous users", "count", total)
+		}
+	}()
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		uids, err := g.Users.ListInactiveAnonymous(ctx, cutoff, gcBatchSize)
+		if err != nil {
+			return err
+		}
+		deleted := g.deleteBatch(ctx, uids, hasFirebase)
+		total += deleted
+		// A batch that deleted nothing would be listed again as is: the failures are logged, retry next sweep.
+		if len(uids) < gcBatchSize || deleted == 0 {
+			return nil
+		}
+	}
+}
+
+// deleteBatch deletes uids, logging the ones that fail, and returns how many were deleted.
+func (g *AnonymousGC) deleteBatch(ctx context.Context, uids []string, hasFirebase bool) int {
+	deleted := 0
+	for _, uid := range uids {
+		if hasFirebase {
+			if err := g.Firebase.Auth.DeleteUser(ctx, uid); err != nil && !fbauth.IsUserNotFound(err) {
+				slog.ErrorContext(ctx, "anonymous gc: delete firebase user", "uid", uid, "err", err)
+				continue
+			}
+		}
+		if err := g.Users.Delete(ctx, uid); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			slog.ErrorContext(ctx, "anonymous gc: delete user", "uid", uid, "err", err)
+			continue
+		}
+		deleted++
+	}
+	return deleted
+}
@@ -0,0 +1,101 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"go-gin-webapi/internal/config"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveKeys are attribute keys whose values are never logged.
+var sensitiveKeys = map[string]bool{
+	"authorization": true,
+	"cookie":        true,
+	"password":      true,
+	"token":         true,
+	"id_token":      true,
+	"access_token":  true,
+	"refresh_token": true,
+	"secret":        true,
+	"api_key":       true,
+}
+
+// sensitiveValue matches credentials embedded in free text, e.g. error messages that quote a URL with ?key=.
+var sensitiveValue = regexp.MustCompile(`(?i)(bearer\s+|[?&]key=|\bpat_|\bdev\.)[A-Za-z0-9._\-]+`)
+
+// New builds the process logger from cfg. Request-scoped attributes (request_id, uid, route) are added from the
+// context by the *Context logging methods.
+func New(w io.Writer, cfg config.LogConfig) (*slog.Logger, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+		return nil, fmt.Errorf("log level %q: %w", cfg.Level, err)
+	}
+	opts := &slog.HandlerOptions{Level: level, ReplaceAttr: redact}
+
+	var h slog.Handler
+	switch strings.ToLower(cfg.Format) {
+	case "json", "":
+		h = slog.NewJSONHandler(w, opts)
+	case "text":
+		h = slog.NewTextHandler(w, opts)
+	default:
+		return nil, fmt.Errorf("log format %q: want json or text", cfg.Format)
+	}
+	return slog.New(contextHandler{h}), nil
+}
+
+func redact(_ []string, a slog.Attr) slog.Attr {
+	if sensitiveKeys[strings.ToLower(a.Key)] {
+		return slog.String(a.Key, redacted)
+	}
+	switch a.Value.Kind() {
+	case slog.KindString:
+		if s := a.Value.String(); sensitiveValue.MatchString(s) {
+			return slog.String(a.Key, redactString(s))
+		}
+	case slog.KindAny:
+		if err, ok := a.Value.Any().(error); ok {
+			return slog.String(a.Key, redactString(err.Error()))
+		}
+	}
+	return a
+}
+
+func redactString(s string) string {
+	return sensitiveValue.ReplaceAllStringFunc(s, func(m string) string {
+		prefix := sensitiveValue.FindStringSubmatch(m)[1]
+		return prefix + redacted
+	})
+}
+
+// contextHandler adds the request attributes stored by Middleware, and the active trace/span IDs, to every record
+// logged with a request context.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if info := requestInfoFrom(ctx); info != nil {
+		r.AddAttrs(info.attrs()...)
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()), slog.String("span_id", sc.SpanID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,155 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"go-gin-webapi/internal/problem"
+	"go-gin-webapi/schemas"
+)
+
+// RequestIDHeader is propagated from the client (or generated) and echoed on the response.
+const RequestIDHeader = "X-Request-ID"
+
+type ctxKey struct{}
+
+// requestInfo is shared by pointer through the request context so later middleware (auth) can fill in the uid.
+type requestInfo struct {
+	mu        sync.Mutex
+	requestID string
+	route     string
+	operation string
+	uid       string
+}
+
+func (i *requestInfo) attrs() []slog.Attr {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	attrs := []slog.Attr{slog.String("request_id", i.requestID)}
+	if i.route != "" {
+		attrs = append(attrs, slog.String("route", i.route))
+	}
+	if i.operation != "" {
+		attrs = append(attrs, slog.String("operation", i.operation))
+	}
+	if i.uid != "" {
+		attrs = append(attrs, slog.String("uid", i.uid))
+	}
+	return attrs
+}
+
+func requestInfoFrom(ctx context.Context) *requestInfo {
+	if ctx == nil {
+		return nil
+	}
+	info, _ := ctx.Value(ctxKey{}).(*requestInfo)
+	return info
+}
+
+// RequestID returns the request ID of ctx, if any.
+func RequestID(ctx context.Context) string {
+	if info := requestInfoFrom(ctx); info != nil {
+		return info.requestID
+	}
+	return ""
+}
+
+// SetUID attaches the authenticated uid to the request's log lines.
+func SetUID(ctx context.Context, uid string) {
+	if info := requestInfoFrom(ctx); info != nil {
+		info.mu.Lock()
+		info.uid = uid
+		info.mu.Unlock()
+	}
+}
+
+// StrictMiddleware attaches the OpenAPI operation ID to the request's log lines. Register it as the
+// outermost strict middleware so the auth and rate limit lines carry it too.
+func StrictMiddleware() schemas.StrictMiddlewareFunc {
+	return func(next schemas.StrictHandlerFunc, operationID string) schemas.StrictHandlerFunc {
+		return func(c *gin.Context, request any) (any, error) {
+			if info := requestInfoFrom(c.Request.Context()); info != nil {
+				info.mu.Lock()
+				info.operation = operationID
+				info.mu.Unlock()
+			}
+			return next(c, request)
+		}
+	}
+}
+
+// Middleware assigns the request ID and writes one access log line per request.
+func Middleware(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		id := c.GetHeader(RequestIDHeader)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+		info := &requestInfo{requestID: id, route: c.FullPath()}
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxKey{}, info))
+		c.Header(RequestIDHeader, id)
+
+		c.Next()
+
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= 500:
+			level = slog.LevelError
+		case status >= 400:
+			level = slog.LevelWarn
+		}
+		attrs := []slog.Attr{
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path),
+			slog.Int("status", status),
+			slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.String("client_ip", c.ClientIP()),
+			slog.Int("bytes", c.Writer.Size()),
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, slog.String("error", c.Errors.String()))
+		}
+		logger.LogAttrs(c.Request.Context(), level, "request", attrs...)
+	}
+}
+
+// Recovery turns panics into a 500 and logs them with the stack trace.
+func Recovery(logger *slog.Logger) gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(io.Discard, func(c *gin.Context, err any) {
+		logger.ErrorContext(c.Request.Context(), "panic recovered",
+			slog.Any("panic", err),
+			slog.String("stack", string(debug.Stack())),
+		)
+		problem.Write(c, problem.New(c, http.StatusInternalServerError, problem.Internal, ""))
+		c.Abort()
+	})
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > 128 {
+		return false
+	}
+	for _, r := range id {
+		if r < 0x21 || r > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
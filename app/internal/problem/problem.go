@@ -0,0 +1,78 @@
+package problem
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-gin-webapi/internal/i18n"
+	"go-gin-webapi/internal/tracing"
+	"go-gin-webapi/schemas"
+)
+
+// MediaType is the content type of every error response (RFC 7807).
+const MediaType = "application/problem+json"
+
+// typePrefix makes a code into the problem's type URI. It is an identifier, not a link.
+const typePrefix = "urn:go-gin-webapi:problem:"
+
+// Codes are part of the API: clients branch on them, so a code is never renamed or reused.
+// Each code's title is problem.<code> in the i18n catalogs.
+const (
+	ValidationFailed     = "validation_failed" // 400, with field errors
+	InvalidJSON          = "invalid_json"
+	InvalidPatch         = "invalid_patch"
+	EmailTaken           = "email_taken"
+	InvalidCredentials   = "invalid_credentials"
+	IdentityRejected     = "identity_rejected" // Firebase refused the sign-up or sign-in for another reason
+	NotRegistered        = "not_registered"
+	AlreadyRegistered    = "already_registered"
+	StatusExists         = "status_exists"
+	TooManyStatuses      = "too_many_statuses"
+	Unauthorized         = "unauthorized"
+	Forbidden            = "forbidden"
+	NotFound             = "not_found"
+	PatchTestFailed      = "patch_test_failed"
+	TransitionNotAllowed = "status_transition_not_allowed"
+	UnsupportedMediaType = "unsupported_media_type"
+	StatusReasonRequired = "status_reason_required"
+	RateLimited          = "rate_limited"
+	Internal             = "internal_error"
+)
+
+// New returns the problem for code; detail may be empty and is expected in the request's language
+// (see i18n.T). The trace ID is taken from ctx and, when ctx is the request's *gin.Context, the request
+// path becomes the instance.
+func New(ctx context.Context, status int, code, detail string) schemas.Problem {
+	p := schemas.Problem{
+		Type:   typePrefix + code,
+		Title:  i18n.T(ctx, "problem."+code),
+		Status: status,
+		Code:   code,
+	}
+	if detail != "" {
+		p.Detail = &detail
+	}
+	if c, ok := ctx.(*gin.Context); ok && c.Request != nil {
+		path := c.Request.URL.Path
+		p.Instance = &path
+	}
+	if id := tracing.TraceID(ctx); id != "" {
+		p.TraceId = &id
+	}
+	return p
+}
+
+// Invalid is a validation_failed problem listing errs.
+func Invalid(ctx context.Context, errs ...schemas.FieldError) schemas.Problem {
+	p := New(ctx, http.StatusBadRequest, ValidationFailed, i18n.T(ctx, "detail.invalid_fields"))
+	p.Errors = &errs
+	return p
+}
+
+// Write sends p from a gin middleware; handlers return their operation's response type instead.
+func Write(c *gin.Context, p schemas.Problem) {
+	c.Header("Content-Type", MediaType)
+	c.JSON(p.Status, p)
+}
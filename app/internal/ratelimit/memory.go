@@ -0,0 +1,62 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// MemoryStore is a per-process token bucket store. Each key's bucket holds up to Requests tokens
+// and refills at Requests/Window.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	calls   int
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	full   time.Duration // time to refill from empty, used to expire idle buckets
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: map[string]*bucket{}}
+}
+
+func (s *MemoryStore) Allow(_ context.Context, key string, l Limit) (Decision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.calls++
+	if s.calls%1024 == 0 {
+		s.sweep(now)
+	}
+
+	rate := float64(l.Requests) / l.Window.Seconds() // tokens per second
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.Requests), last: now, full: l.Window}
+		s.buckets[key] = b
+	}
+	b.tokens = math.Min(float64(l.Requests), b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return Decision{Allowed: true}, nil
+	}
+	wait := time.Duration((1 - b.tokens) / rate * float64(time.Second))
+	return Decision{Allowed: false, RetryAfter: wait}, nil
+}
+
+// sweep drops buckets that have been idle long enough to be full again.
+func (s *MemoryStore) sweep(now time.Time) {
+	for k, b := range s.buckets {
+		if now.Sub(b.last) > b.full {
+			delete(s.buckets, k)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreAllow(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit Limit
+		// calls is the number of requests made in a row; the ones past allowed are refused.
+		calls   int
+		allowed int
+	}{
+		{name: "under the limit", limit: Limit{ByIP, 3, time.Minute}, calls: 2, allowed: 2},
+		{name: "at the limit", limit: Limit{ByIP, 3, time.Minute}, calls: 3, allowed: 3},
+		{name: "over the limit", limit: Limit{ByIP, 3, time.Minute}, calls: 5, allowed: 3},
+		{name: "single request", limit: Limit{ByEmail, 1, time.Hour}, calls: 2, allowed: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewMemoryStore()
+			for i := range tt.calls {
+				d, err := s.Allow(context.Background(), "key", tt.limit)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := i < tt.allowed; d.Allowed != want {
+					t.Fatalf("call %d: Allowed = %v, want %v", i+1, d.Allowed, want)
+				}
+				if d.Allowed {
+					continue
+				}
+				// One token refills in Window/Requests.
+				refill := tt.limit.Window / time.Duration(tt.limit.Requests)
+				if d.RetryAfter <= 0 || d.RetryAfter > refill {
+					t.Errorf("call %d: RetryAfter = %v, want in (0, %v]", i+1, d.RetryAfter, refill)
+				}
+			}
+		})
+	}
+}
+
+func TestMemoryStoreKeysAreSeparate(t *testing.T) {
+	s := NewMemoryStore()
+	l := Limit{ByIP, 1, time.Minute}
+	for _, key := range []string{"a", "b"} {
+		d, err := s.Allow(context.Background(), key, l)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !d.Allowed {
+			t.Errorf("first request of %q refused", key)
+		}
+	}
+	if d, _ := s.Allow(context.Background(), "a", l); d.Allowed {
+		t.Error("second request of \"a\" allowed")
+	}
+}
+
+func TestMemoryStoreRefills(t *testing.T) {
+	s := NewMemoryStore()
+	l := Limit{ByIP, 2, 20 * time.Millisecond}
+	for range 2 {
+		if d, _ := s.Allow(context.Background(), "key", l); !d.Allowed {
+			t.Fatal("request under the limit refused")
+		}
+	}
+	if d, _ := s.Allow(context.Background(), "key", l); d.Allowed {
+		t.Fatal("request over the limit allowed")
+	}
+	time.Sleep(l.Window)
+	if d, _ := s.Allow(context.Background(), "key", l); !d.Allowed {
+		t.Error("request refused after the window")
+	}
+}
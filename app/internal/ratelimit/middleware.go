@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"go-gin-webapi/internal/apispec"
+	"go-gin-webapi/schemas"
+)
+
+// ErrLimited is returned by Middleware instead of running the handler; Retry-After is already set.
+var ErrLimited = errors.New("too many requests")
+
+// Middleware enforces rules for the operation being called. uid returns the authenticated user of a
+// request, or "" for none (auth.UID; this package can't import auth, which depends on config, which
+// validates rules with ParseRules). It must run after auth.Middleware so uid limits can see the principal.
+func Middleware(store Store, rules Rules, ops *apispec.Index, uid func(context.Context) string) schemas.StrictMiddlewareFunc {
+	return func(next schemas.StrictHandlerFunc, operationID string) schemas.StrictHandlerFunc {
+		return func(c *gin.Context, request any) (any, error) {
+			op, ok := ops.ByOperationID(operationID)
+			if !ok {
+				return next(c, request)
+			}
+			name := op.Method + " " + op.Path
+			limits := rules[name]
+			if len(limits) == 0 {
+				return next(c, request)
+			}
+
+			for _, l := range limits {
+				v := keyValue(c, request, l.By, uid)
+				if v == "" {
+					continue
+				}
+				d, err := store.Allow(c.Request.Context(), storeKey(name, l, v), l)
+				if err != nil {
+					// Fail open: a broken limiter must not take the API down with it.
+					slog.WarnContext(c.Request.Context(), "rate limit store failed; allowing request", "operation", name, "limit", l.String(), "err", err)
+					continue
+				}
+				if !d.Allowed {
+					// Stop here so the remaining limits aren't charged for a request that isn't served.
+					c.Header("Retry-After", strconv.Itoa(int(math.Ceil(max(d.RetryAfter, time.Second).Seconds()))))
+					return nil, ErrLimited
+				}
+			}
+			return next(c, request)
+		}
+	}
+}
+
+func keyValue(c *gin.Context, request any, by KeyKind, uid func(context.Context) string) string {
+	switch by {
+	case ByIP:
+		return c.ClientIP()
+	case ByUID:
+		return uid(c)
+	case ByEmail:
+		return bodyEmail(request)
+	}
+	return ""
+}
+
+// bodyEmail reads `email` from the decoded JSON body of a strict request object.
+func bodyEmail(request any) string {
+	b, err := json.Marshal(request)
+	if err != nil {
+		return ""
+	}
+	var req struct {
+		Body *struct {
+			Email string `json:"email"`
+		}
+	}
+	if err := json.Unmarshal(b, &req); err != nil || req.Body == nil {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(req.Body.Email))
+}
+
+// storeKey hashes the key so emails aren't persisted verbatim and keys fit the MySQL column.
+func storeKey(op string, l Limit, value string) string {
+	sum := sha256.Sum256([]byte(op + "|" + string(l.By) + "|" + value))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,129 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+)
+
+// purgeBatch caps the rows one global purge deletes, keeping the statement short.
+const purgeBatch = 1000
+
+// MySQLStore shares counters between instances through the rate_limit_counters table.
+// It uses a sliding window counter: the previous fixed window's count is weighted by how much of it
+// still overlaps the sliding window.
+type MySQLStore struct {
+	db *sql.DB
+	// longest is the longest window in milliseconds seen so far; older rows can't affect any decision.
+	longest atomic.Int64
+}
+
+func NewMySQLStore(db *sql.DB) *MySQLStore {
+	return &MySQLStore{db: db}
+}
+
+func (s *MySQLStore) Allow(ctx context.Context, key string, l Limit) (Decision, error) {
+	now := time.Now()
+	window := l.Window.Milliseconds()
+	cur := now.UnixMilli() / window * window
+	prev := cur - window
+	for {
+		old := s.longest.Load()
+		if window <= old || s.longest.CompareAndSwap(old, window) {
+			break
+		}
+	}
+
+	// The hit is counted first so the row lock serializes concurrent requests for the key, and rolled
+	// back when the request is refused: refused requests don't use up the budget.
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Decision{}, err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO rate_limit_counters (bucket_key, window_start, hits) VALUES (?, ?, 1)
+		 ON DUPLICATE KEY UPDATE hits = hits + 1`,
+		key, cur,
+	); err != nil {
+		return Decision{}, err
+	}
+
+	var curHits, prevHits int64
+	rows, err := tx.QueryContext(ctx,
+		`SELECT window_start, hits FROM rate_limit_counters WHERE bucket_key = ? AND window_start IN (?, ?)`,
+		key, prev, cur,
+	)
+	if err != nil {
+		return Decision{}, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var start, hits int64
+		if err := rows.Scan(&start, &hits); err != nil {
+			return Decision{}, err
+		}
+		if start == cur {
+			curHits = hits
+		} else {
+			prevHits = hits
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return Decision{}, err
+	}
+
+	d := slidingWindow(prevHits, curHits-1, int64(l.Requests), window, now.UnixMilli()-cur)
+	if !d.Allowed {
+		return d, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return Decision{}, err
+	}
+
+	// Occasionally drop windows that can no longer affect any decision: this key's, and those of keys
+	// that are never hit again, like the memory store's sweep.
+	if rand.IntN(100) == 0 {
+		if _, err := s.db.ExecContext(ctx,
+			`DELETE FROM rate_limit_counters WHERE bucket_key = ? AND window_start < ?`,
+			key, prev,
+		); err != nil {
+			return Decision{}, err
+		}
+		if err := s.purge(ctx, now); err != nil {
+			return Decision{}, err
+		}
+	}
+	return d, nil
+}
+
+// slidingWindow decides a request made offset milliseconds into the current window, given the hits of
+// the previous and current windows before it. The previous window counts for the part of it the sliding
+// window still overlaps: the request is allowed while prev*(window-offset)/window + cur + 1 <= limit.
+// When refused, RetryAfter is the first moment that holds again, assuming no other hits meanwhile.
+func slidingWindow(prev, cur, limit, window, offset int64) Decision {
+	if prev*(window-offset) <= (limit-cur-1)*window {
+		return Decision{Allowed: true}
+	}
+	var wait int64
+	if cur < limit {
+		// Room in the current window: wait until enough of the previous one has slid out.
+		wait = window - (limit-cur-1)*window/prev - offset
+	} else {
+		// The current window alone is full: wait into the next one, where it becomes the previous window.
+		wait = window - offset + window - (limit-1)*window/cur
+	}
+	return Decision{Allowed: false, RetryAfter: time.Duration(wait) * time.Millisecond}
+}
+
+// purge deletes up to purgeBatch rows of any key older than two of the longest windows. Limits of other
+// processes are the same rules, so their windows are no longer than the ones seen here.
+func (s *MySQLStore) purge(ctx context.Context, now time.Time) error {
+	_, err := s.db.ExecContext(ctx,
+		`DELETE FROM rate_limit_counters WHERE window_start < ? LIMIT ?`,
+		now.UnixMilli()-2*s.longest.Load(), purgeBatch,
+	)
+	return err
+}
@@ -0,0 +1,60 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSlidingWindow(t *testing.T) {
+	const window = 60000 // 1m in milliseconds
+	tests := []struct {
+		name string
+		// hits of the previous and current windows before the request, offset ms into the current one.
+		prev, cur, offset int64
+		limit             int64
+		wantAllowed       bool
+	}{
+		{name: "empty", limit: 5, wantAllowed: true},
+		{name: "last request of the window", cur: 4, limit: 5, wantAllowed: true},
+		{name: "current window full", cur: 5, offset: 10000, limit: 5},
+		{name: "previous window still counts", prev: 5, cur: 2, offset: 30000, limit: 5},
+		{name: "previous window mostly slid out", prev: 5, cur: 2, offset: 45000, limit: 5, wantAllowed: true},
+		{name: "full at the end of the window", prev: 5, cur: 5, offset: 59999, limit: 5},
+		{name: "limit of one", prev: 1, offset: 100, limit: 1},
+		{name: "limit of one, full", cur: 1, offset: 100, limit: 1},
+		{name: "uneven weight", prev: 7, cur: 2, offset: 1, limit: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := slidingWindow(tt.prev, tt.cur, tt.limit, window, tt.offset)
+			if d.Allowed != tt.wantAllowed {
+				t.Fatalf("Allowed = %v, want %v", d.Allowed, tt.wantAllowed)
+			}
+			if d.Allowed {
+				return
+			}
+			wait := d.RetryAfter.Milliseconds()
+			if wait <= 0 || d.RetryAfter%time.Millisecond != 0 {
+				t.Fatalf("RetryAfter = %v, want a positive number of milliseconds", d.RetryAfter)
+			}
+			// A client retrying at Retry-After is allowed; one retrying a millisecond earlier is not.
+			at := func(after int64) Decision {
+				prev, cur, offset := tt.prev, tt.cur, tt.offset+after
+				for ; offset >= window; offset -= window {
+					prev, cur = cur, 0
+				}
+				return slidingWindow(prev, cur, tt.limit, window, offset)
+			}
+			if !at(wait).Allowed {
+				t.Errorf("retry after %dms refused", wait)
+			}
+			if at(wait - 1).Allowed {
+				t.Errorf("retry after %dms allowed; Retry-After %dms is longer than needed", wait-1, wait)
+			}
+			// The middleware rounds up to whole seconds; waiting longer must not be refused either.
+			if secs := (wait + 999) / 1000 * 1000; !at(secs).Allowed {
+				t.Errorf("retry after %dms refused", secs)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// KeyKind is the request attribute a limit is counted by.
+type KeyKind string
+
+const (
+	ByIP    KeyKind = "ip"
+	ByEmail KeyKind = "email"
+	ByUID   KeyKind = "uid"
+)
+
+// Limit allows Requests per Window for a single key.
+type Limit struct {
+	By       KeyKind
+	Requests int
+	Window   time.Duration
+}
+
+func (l Limit) String() string {
+	return fmt.Sprintf("%s=%d/%s", l.By, l.Requests, l.Window)
+}
+
+// Decision is the outcome of Store.Allow.
+type Decision struct {
+	Allowed bool
+	// RetryAfter is how long the client should wait before retrying (only when !Allowed).
+	RetryAfter time.Duration
+}
+
+// Store counts requests per key.
+type Store interface {
+	Allow(ctx context.Context, key string, l Limit) (Decision, error)
+}
+
+// Rules maps an operation ("METHOD /openapi/path") to the limits applied to it.
+type Rules map[string][]Limit
+
+// DefaultRules throttle credential endpoints per client IP and per target account.
+var DefaultRules = Rules{
+	"POST /login":                  {{ByIP, 20, time.Minute}, {ByEmail, 5, 15 * time.Minute}},
+	"POST /register":               {{ByIP, 10, time.Hour}, {ByEmail, 3, time.Hour}},
+	"POST /register/anonymous":     {{ByIP, 10, time.Hour}},
+	"POST /token":                  {{ByIP, 60, time.Minute}},
+	"POST /users/{user_id}/tokens": {{ByUID, 10, time.Hour}},
+}
+
+// ParseRules parses rule overrides, e.g.
+//
+//	POST /login: ip=20/1m, email=5/15m; POST /register: ip=10/1h
+//
+// Rules for operations not mentioned keep their defaults; an operation with no limits ("POST /login:") disables them.
+func ParseRules(s string, defaults Rules) (Rules, error) {
+	out := Rules{}
+	for k, v := range defaults {
+		out[k] = v
+	}
+	for _, rule := range strings.Split(s, ";") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		op, limits, ok := strings.Cut(rule, ":")
+		if !ok {
+			return nil, fmt.Errorf("rate limit rule %q: missing ':'", rule)
+		}
+		op = strings.Join(strings.Fields(op), " ")
+		method, path, ok := strings.Cut(op, " ")
+		if !ok || !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf("rate limit rule %q: operation must be \"METHOD /path\"", rule)
+		}
+		op = strings.ToUpper(method) + " " + path
+
+		var ls []Limit
+		for _, part := range strings.Split(limits, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			l, err := parseLimit(part)
+			if err != nil {
+				return nil, fmt.Errorf("rate limit rule %q: %w", rule, err)
+			}
+			ls = append(ls, l)
+		}
+		out[op] = ls
+	}
+	return out, nil
+}
+
+func parseLimit(s string) (Limit, error) {
+	by, spec, ok := strings.Cut(s, "=")
+	if !ok {
+		return Limit{}, fmt.Errorf("limit %q: want key=requests/window", s)
+	}
+	kind := KeyKind(strings.TrimSpace(by))
+	switch kind {
+	case ByIP, ByEmail, ByUID:
+	default:
+		return Limit{}, fmt.Errorf("limit %q: key must be ip, email or uid", s)
+	}
+	n, w, ok := strings.Cut(spec, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("limit %q: want key=requests/window", s)
+	}
+	requests, err := strconv.Atoi(strings.TrimSpace(n))
+	if err != nil || requests < 1 {
+		return Limit{}, fmt.Errorf("limit %q: requests must be a positive integer", s)
+	}
+	window, err := time.ParseDuration(strings.TrimSpace(w))
+	if err != nil || window <= 0 {
+		return Limit{}, fmt.Errorf("limit %q: window must be a positive duration", s)
+	}
+	return Limit{By: kind, Requests: requests, Window: window}, nil
+}
@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRules(t *testing.T) {
+	defaults := Rules{
+		"POST /login":    {{ByIP, 20, time.Minute}},
+		"POST /register": {{ByIP, 10, time.Hour}},
+	}
+	tests := []struct {
+		name    string
+		in      string
+		want    Rules
+		wantErr string
+	}{
+		{
+			name: "empty keeps the defaults",
+			in:   "",
+			want: defaults,
+		},
+		{
+			name: "override and add",
+			in:   "POST /login: ip=5/30s, email=3/15m; post  /users/{user_id}/tokens: uid=1/1h;",
+			want: Rules{
+				"POST /login":                  {{ByIP, 5, 30 * time.Second}, {ByEmail, 3, 15 * time.Minute}},
+				"POST /register":               {{ByIP, 10, time.Hour}},
+				"POST /users/{user_id}/tokens": {{ByUID, 1, time.Hour}},
+			},
+		},
+		{
+			name: "no limits disables the operation",
+			in:   "POST /register:",
+			want: Rules{
+				"POST /login":    {{ByIP, 20, time.Minute}},
+				"POST /register": nil,
+			},
+		},
+		{name: "missing colon", in: "POST /login ip=1/1m", wantErr: "missing ':'"},
+		{name: "missing path", in: "POST: ip=1/1m", wantErr: `"METHOD /path"`},
+		{name: "relative path", in: "POST login: ip=1/1m", wantErr: `"METHOD /path"`},
+		{name: "unknown key", in: "POST /login: host=1/1m", wantErr: "key must be ip, email or uid"},
+		{name: "no window", in: "POST /login: ip=1", wantErr: "want key=requests/window"},
+		{name: "zero requests", in: "POST /login: ip=0/1m", wantErr: "positive integer"},
+		{name: "bad window", in: "POST /login: ip=1/soon", wantErr: "positive duration"},
+		{name: "negative window", in: "POST /login: ip=1/-1m", wantErr: "positive duration"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRules(tt.in, defaults)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseRules(%q) error = %v, want it to contain %q", tt.in, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRules(%q): %v", tt.in, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseRules(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRulesLeavesDefaults(t *testing.T) {
+	defaults := Rules{"POST /login": {{ByIP, 20, time.Minute}}}
+	if _, err := ParseRules("POST /login: ip=1/1s", defaults); err != nil {
+		t.Fatal(err)
+	}
+	if want := (Limit{ByIP, 20, time.Minute}); defaults["POST /login"][0] != want {
+		t.Errorf("defaults changed to %v", defaults["POST /login"])
+	}
+}
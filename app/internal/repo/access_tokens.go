@@ -0,0 +1,141 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+)
+
+// AccessToken is a personal access token. Only the SHA-256 hash of the secret is stored.
+type AccessToken struct {
+	ID         string
+	Owner      string
+	Name       string
+	TokenHash  string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+type AccessTokenRepo struct {
+	db    *sql.DB
+	reads *readRouter
+}
+
+func (r *AccessTokenRepo) Create(ctx context.Context, t AccessToken) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO personal_access_tokens (id, owner, name, token_hash, scopes, expires_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		t.ID, t.Owner, t.Name, t.TokenHash, strings.Join(t.Scopes, ","), t.ExpiresAt,
+	)
+	if err == nil {
+		r.reads.wrote(t.Owner)
+	}
+	return err
+}
+
+func (r *AccessTokenRepo) GetByIDOwner(ctx context.Context, id, owner string) (AccessToken, error) {
+	row := r.reads.reader(owner).QueryRowContext(ctx,
+		`SELECT id, owner, name, scopes, expires_at, last_used_at, created_at FROM personal_access_tokens WHERE id = ? AND owner = ?`,
+		id, owner,
+	)
+	t, err := scanAccessToken(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return AccessToken{}, sql.ErrNoRows
+		}
+		return AccessToken{}, err
+	}
+	return t, nil
+}
+
+func (r *AccessTokenRepo) ListByOwner(ctx context.Context, owner string) ([]AccessToken, error) {
+	rows, err := r.reads.reader(owner).QueryContext(ctx,
+		`SELECT id, owner, name, scopes, expires_at, last_used_at, created_at
+		 FROM personal_access_tokens WHERE owner = ? ORDER BY created_at DESC`,
+		owner,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []AccessToken
+	for rows.Next() {
+		t, err := scanAccessToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *AccessTokenRepo) DeleteByIDOwner(ctx context.Context, id, owner string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM personal_access_tokens WHERE id = ? AND owner = ?`, id, owner)
+	if err != nil {
+		return err
+	}
+	r.reads.wrote(owner)
+	n, _ := res.RowsAffected()
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// LookupAccessToken implements auth.AccessTokenStore.
+// Tokens of a disabled owner are not found; they work again once the owner is re-enabled.
+func (r *AccessTokenRepo) LookupAccessToken(ctx context.Context, hash string) (tokenID, uid string, scopes []string, found bool, err error) {
+	var s string
+	row := r.db.QueryRowContext(ctx,
+		`SELECT t.id, t.owner, t.scopes FROM personal_access_tokens t
+		 JOIN users u ON u.uid = t.owner
+		 WHERE t.token_hash = ? AND (t.expires_at IS NULL OR t.expires_at > NOW()) AND u.disabled = 0`,
+		hash,
+	)
+	if err := row.Scan(&tokenID, &uid, &s); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", "", nil, false, nil
+		}
+		return "", "", nil, false, err
+	}
+	return tokenID, uid, splitScopes(s), true, nil
+}
+
+// MarkAccessTokenUsed implements auth.AccessTokenStore.
+// Writes are coalesced to once a minute per token to keep hot tokens from hammering the row.
+func (r *AccessTokenRepo) MarkAccessTokenUsed(ctx context.Context, tokenID string) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE personal_access_tokens SET last_used_at = NOW()
+		 WHERE id = ? AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL 1 MINUTE)`,
+		tokenID,
+	)
+	return err
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAccessToken(row rowScanner) (AccessToken, error) {
+	var t AccessToken
+	var scopes string
+	if err := row.Scan(&t.ID, &t.Owner, &t.Name, &scopes, &t.ExpiresAt, &t.LastUsedAt, &t.CreatedAt); err != nil {
+		return AccessToken{}, err
+	}
+	t.Scopes = splitScopes(scopes)
+	return t, nil
+}
+
+func splitScopes(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(s, ",")
+}
@@ -1,20 +1,44 @@
 package repo
 
-import "database/sql"
+import (
+	"context"
+	"database/sql"
+	"time"
+)
 
 type Repos struct {
 	Users     *UserRepo
+	Roles     *UserRoleRepo
 	Todos     *TodoRepo
 	Statuses  *TodoStatusRepo
 	Goodlucks *GoodluckRepo
+	Tokens    *AccessTokenRepo
+	Revisions *TodoRevisionRepo
+	Workflows *TodoWorkflowRepo
+	Schema    *SchemaRepo
 }
 
-func New(db *sql.DB) *Repos {
+// queryer is implemented by both *sql.DB and *sql.Tx.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// New wires the repositories to db. If replica is not nil, per-user reads (GetByUID, ListByOwner, GetByIDOwner)
+// go there, except for stickiness after that user's last write.
+func New(db, replica *sql.DB, stickiness time.Duration) *Repos {
+	reads := &readRouter{primary: db, replica: replica, stickiness: stickiness}
 	return &Repos{
-		Users:     &UserRepo{db: db},
-		Todos:     &TodoRepo{db: db},
+		Users:     &UserRepo{db: db, reads: reads},
+		Roles:     &UserRoleRepo{db: db},
+		Todos:     &TodoRepo{db: db, reads: reads},
 		Statuses:  &TodoStatusRepo{db: db},
 		Goodlucks: &GoodluckRepo{db: db},
+		Tokens:    &AccessTokenRepo{db: db, reads: reads},
+		Revisions: &TodoRevisionRepo{db: db, reads: reads},
+		Workflows: &TodoWorkflowRepo{db: db},
+		Schema:    &SchemaRepo{db: db},
 	}
 }
 
@@ -0,0 +1,53 @@
+package repo
+
+import (
+	"database/sql"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// pruneEvery is how many recorded writes trigger a sweep of expired stickiness entries.
+const pruneEvery = 1024
+
+// readRouter sends read-only queries to the replica, except for users who wrote within the stickiness window,
+// whose reads stay on the primary so they see their own writes despite replication lag.
+// Stickiness is per instance; behind a load balancer, a user's next request may still read stale data elsewhere.
+type readRouter struct {
+	primary    *sql.DB
+	replica    *sql.DB
+	stickiness time.Duration
+
+	lastWrite sync.Map // uid -> time.Time
+	writes    atomic.Uint64
+}
+
+// reader returns the DB to read uid's data from.
+func (r *readRouter) reader(uid string) *sql.DB {
+	if r.replica == nil {
+		return r.primary
+	}
+	if v, ok := r.lastWrite.Load(uid); ok {
+		if time.Since(v.(time.Time)) < r.stickiness {
+			return r.primary
+		}
+		r.lastWrite.Delete(uid)
+	}
+	return r.replica
+}
+
+// wrote pins uid's reads to the primary for the stickiness window.
+func (r *readRouter) wrote(uid string) {
+	if r.replica == nil {
+		return
+	}
+	r.lastWrite.Store(uid, time.Now())
+	if r.writes.Add(1)%pruneEvery == 0 {
+		r.lastWrite.Range(func(k, v any) bool {
+			if time.Since(v.(time.Time)) >= r.stickiness {
+				r.lastWrite.Delete(k)
+			}
+			return true
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+)
+
+// SchemaVersion is the schema_migrations version this build expects.
+// Bump it together with the INSERT at the end of init_table.sql whenever the schema changes,
+// and add the upgrade for existing databases to .devcontainer/db/migrations.
+const SchemaVersion = 6
+
+type SchemaRepo struct {
+	db *sql.DB
+}
+
+// Version returns the highest applied schema_migrations version, 0 if none.
+func (r *SchemaRepo) Version(ctx context.Context) (int, error) {
+	var v sql.NullInt64
+	if err := r.db.QueryRowContext(ctx, `SELECT MAX(version) FROM schema_migrations`).Scan(&v); err != nil {
+		return 0, err
+	}
+	return int(v.Int64), nil
+}
@@ -0,0 +1,75 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// TodoBatch applies several writes to one owner's todos inside a single transaction (see TodoRepo.Batch).
+type TodoBatch struct {
+	r     *TodoRepo
+	tx    *sql.Tx
+	owner string
+	by    string
+}
+
+// Batch runs fn in one transaction on behalf of by and commits if fn returns nil.
+func (r *TodoRepo) Batch(ctx context.Context, owner, by string, fn func(b *TodoBatch) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(&TodoBatch{r: r, tx: tx, owner: owner, by: by}); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	r.reads.wrote(owner)
+	return nil
+}
+
+// Try runs fn under a savepoint: if fn fails only its writes are undone and the batch goes on.
+// An error from the savepoint statements themselves is wrapped in SavepointError, and the batch must stop.
+func (b *TodoBatch) Try(ctx context.Context, fn func() error) error {
+	if _, err := b.tx.ExecContext(ctx, `SAVEPOINT batch_item`); err != nil {
+		return &SavepointError{err}
+	}
+	if err := fn(); err != nil {
+		if _, rerr := b.tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT batch_item`); rerr != nil {
+			return &SavepointError{errors.Join(err, rerr)}
+		}
+		return err
+	}
+	if _, err := b.tx.ExecContext(ctx, `RELEASE SAVEPOINT batch_item`); err != nil {
+		return &SavepointError{err}
+	}
+	return nil
+}
+
+// SavepointError means the batch transaction can no longer be used.
+type SavepointError struct{ Err error }
+
+func (e *SavepointError) Error() string { return "savepoint: " + e.Err.Error() }
+func (e *SavepointError) Unwrap() error { return e.Err }
+
+// Create is TodoRepo.Create within the batch; t.Owner must be the batch owner.
+func (b *TodoBatch) Create(ctx context.Context, t Todo, reason string) error {
+	if t.Owner != b.owner {
+		return errors.New("todo batch: owner mismatch")
+	}
+	return createTodo(ctx, b.tx, t, reason)
+}
+
+// Update is TodoRepo.UpdateByIDOwner within the batch.
+func (b *TodoBatch) Update(ctx context.Context, id string, u TodoUpdate) error {
+	return b.r.update(ctx, b.tx, id, b.owner, b.by, u)
+}
+
+// Delete is TodoRepo.DeleteByIDOwner within the batch.
+func (b *TodoBatch) Delete(ctx context.Context, id string) error {
+	return deleteTodo(ctx, b.tx, id, b.owner)
+}
@@ -0,0 +1,132 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Revisioned todo fields, as stored in todo_revisions.changed_fields.
+const (
+	FieldTitle       = "title"
+	FieldContent     = "content"
+	FieldStatus      = "status"
+	FieldDueDatetime = "due_datetime"
+)
+
+var allTodoFields = []string{FieldTitle, FieldContent, FieldStatus, FieldDueDatetime}
+
+// TodoRevision is a todo as it was after one create or update. Changed names the fields that differ from Rev-1.
+type TodoRevision struct {
+	Todo        string
+	Rev         int
+	Title       string
+	Content     string
+	Status      string
+	DueDatetime *time.Time
+	Changed     []string
+	ChangedBy   string
+	ChangedAt   time.Time
+}
+
+type TodoRevisionRepo struct {
+	db    *sql.DB
+	reads *readRouter
+}
+
+const revisionColumns = `r.todo, r.rev, r.title, r.content, r.status, r.due_datetime, r.changed_fields, r.changed_by, r.changed_at`
+
+// ListByTodo returns the revisions of owner's todo, oldest first.
+func (r *TodoRevisionRepo) ListByTodo(ctx context.Context, todoID, owner string) ([]TodoRevision, error) {
+	rows, err := r.reads.reader(owner).QueryContext(ctx,
+		`SELECT `+revisionColumns+` FROM todo_revisions r JOIN todos t ON t.id = r.todo
+		 WHERE r.todo = ? AND t.owner = ? ORDER BY r.rev`,
+		todoID, owner,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []TodoRevision
+	for rows.Next() {
+		rev, err := scanRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Get returns one revision of owner's todo, or sql.ErrNoRows.
+func (r *TodoRevisionRepo) Get(ctx context.Context, todoID, owner string, rev int) (TodoRevision, error) {
+	row := r.reads.reader(owner).QueryRowContext(ctx,
+		`SELECT `+revisionColumns+` FROM todo_revisions r JOIN todos t ON t.id = r.todo
+		 WHERE r.todo = ? AND t.owner = ? AND r.rev = ?`,
+		todoID, owner, rev,
+	)
+	out, err := scanRevision(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return TodoRevision{}, sql.ErrNoRows
+	}
+	return out, err
+}
+
+func scanRevision(row interface{ Scan(...any) error }) (TodoRevision, error) {
+	var (
+		rev     TodoRevision
+		changed string
+	)
+	if err := row.Scan(&rev.Todo, &rev.Rev, &rev.Title, &rev.Content, &rev.Status, &rev.DueDatetime, &changed, &rev.ChangedBy, &rev.ChangedAt); err != nil {
+		return TodoRevision{}, err
+	}
+	if changed != "" {
+		rev.Changed = strings.Split(changed, ",")
+	}
+	return rev, nil
+}
+
+// insertRevision appends t as the next revision. The caller must hold the todos row lock (or have just inserted it).
+func insertRevision(ctx context.Context, tx *sql.Tx, t Todo, changed []string, by string) error {
+	var rev int
+	if err := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(rev), 0) + 1 FROM todo_revisions WHERE todo = ?`, t.ID).Scan(&rev); err != nil {
+		return err
+	}
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO todo_revisions (todo, rev, title, content, status, due_datetime, changed_fields, changed_by)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		t.ID, rev, t.Title, t.Content, t.Status, t.DueDatetime, strings.Join(changed, ","), by,
+	)
+	return err
+}
+
+// changedFields lists the fields that differ between two versions of a todo.
+func changedFields(old, cur Todo) []string {
+	var out []string
+	if old.Title != cur.Title {
+		out = append(out, FieldTitle)
+	}
+	if old.Content != cur.Content {
+		out = append(out, FieldContent)
+	}
+	if old.Status != cur.Status {
+		out = append(out, FieldStatus)
+	}
+	if !sameTime(old.DueDatetime, cur.DueDatetime) {
+		out = append(out, FieldDueDatetime)
+	}
+	return out
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
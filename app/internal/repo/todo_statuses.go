@@ -5,14 +5,52 @@ import (
 	"database/sql"
 )
 
+// TodoStatus is a row of todo_statuses. Status is the code stored in todos.status; Label is the value used by the API.
+// Slug is the API's language-neutral status_code, "" if the status has none.
+type TodoStatus struct {
+	Status     string
+	Label      string
+	Slug       string
+	SortOrder  int
+	IsTerminal bool
+}
+
 type TodoStatusRepo struct {
 	db *sql.DB
 }
 
-func (r *TodoStatusRepo) Ensure(ctx context.Context, status string) error {
-	// Ensure status code exists in todo_statuses (keeps FK valid).
-	_, err := r.db.ExecContext(ctx, `INSERT IGNORE INTO todo_statuses (status) VALUES (?)`, status)
-	return err
-}
+// List returns every status ordered by sort_order.
+func (r *TodoStatusRepo) List(ctx context.Context) ([]TodoStatus, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT status, label, slug, sort_order, is_terminal FROM todo_statuses ORDER BY sort_order, status`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
+	var out []TodoStatus
+	for rows.Next() {
+		var (
+			s    TodoStatus
+			slug sql.NullString
+		)
+		if err := rows.Scan(&s.Status, &s.Label, &slug, &s.SortOrder, &s.IsTerminal); err != nil {
+			return nil, err
+		}
+		s.Slug = slug.String
+		out = append(out, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
+func (r *TodoStatusRepo) Create(ctx context.Context, s TodoStatus) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO todo_statuses (status, label, slug, sort_order, is_terminal) VALUES (?, ?, ?, ?, ?)`,
+		s.Status, s.Label, nullIfEmpty(s.Slug), s.SortOrder, s.IsTerminal,
+	)
+	return err
+}
@@ -0,0 +1,64 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+)
+
+// TodoTransition allows a todo of Owner to move from one status code to another.
+type TodoTransition struct {
+	From           string
+	To             string
+	RequiresReason bool
+}
+
+type TodoWorkflowRepo struct {
+	db *sql.DB
+}
+
+// ListByOwner returns owner's transition rules. None means every transition is allowed.
+func (r *TodoWorkflowRepo) ListByOwner(ctx context.Context, owner string) ([]TodoTransition, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT from_status, to_status, requires_reason FROM todo_status_transitions WHERE owner = ? ORDER BY from_status, to_status`,
+		owner,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []TodoTransition
+	for rows.Next() {
+		var t TodoTransition
+		if err := rows.Scan(&t.From, &t.To, &t.RequiresReason); err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReplaceByOwner swaps owner's rules for ts in one transaction.
+func (r *TodoWorkflowRepo) ReplaceByOwner(ctx context.Context, owner string, ts []TodoTransition) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM todo_status_transitions WHERE owner = ?`, owner); err != nil {
+		return err
+	}
+	for _, t := range ts {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO todo_status_transitions (owner, from_status, to_status, requires_reason) VALUES (?, ?, ?, ?)`,
+			owner, t.From, t.To, t.RequiresReason,
+		); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
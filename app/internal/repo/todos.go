@@ -19,23 +19,72 @@ type Todo struct {
 }
 
 type TodoRepo struct {
-	db *sql.DB
+	db    *sql.DB
+	reads *readRouter
 }
 
-func (r *TodoRepo) Create(ctx context.Context, t Todo) error {
-	_, err := r.db.ExecContext(ctx,
+// TodoUpdate holds the fields to change; nil fields are kept.
+type TodoUpdate struct {
+	Title       *string
+	Content     *string
+	Status      *StatusChange
+	DueDatetime *time.Time
+	// ClearDueDatetime sets due_datetime to NULL (DueDatetime must be nil).
+	ClearDueDatetime bool
+}
+
+// StatusChange is a status update for UpdateByIDOwner, recorded in todo_status_history.
+type StatusChange struct {
+	Status string
+	Reason string
+	// Allow is called with the current status while the row is locked. A non-nil error aborts the update
+	// and is returned unchanged. It is not called when the status stays the same.
+	Allow func(from string) error
+}
+
+// Create inserts t and records it as revision 1 and its initial status, with reason, in todo_status_history.
+func (r *TodoRepo) Create(ctx context.Context, t Todo, reason string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := createTodo(ctx, tx, t, reason); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	r.reads.wrote(t.Owner)
+	return nil
+}
+
+func createTodo(ctx context.Context, tx *sql.Tx, t Todo, reason string) error {
+	if _, err := tx.ExecContext(ctx,
 		`INSERT INTO todos (id, owner, status, title, content, due_datetime) VALUES (?, ?, ?, ?, ?, ?)`,
 		t.ID, t.Owner, t.Status, t.Title, t.Content, t.DueDatetime,
-	)
-	return err
+	); err != nil {
+		return err
+	}
+	if err := insertStatusHistory(ctx, tx, t.ID, nil, t.Status, reason, t.Owner); err != nil {
+		return err
+	}
+	return insertRevision(ctx, tx, t, allTodoFields, t.Owner)
 }
 
 func (r *TodoRepo) GetByIDOwner(ctx context.Context, id, owner string) (Todo, error) {
+	return r.get(ctx, r.reads.reader(owner), id, owner, false)
+}
+
+// get reads from q explicitly; read-modify-write paths must use the primary (or a transaction with forUpdate).
+func (r *TodoRepo) get(ctx context.Context, q queryer, id, owner string, forUpdate bool) (Todo, error) {
+	query := `SELECT id, owner, status, title, content, due_datetime, created_at, updated_at FROM todos WHERE id = ? AND owner = ?`
+	if forUpdate {
+		query += ` FOR UPDATE`
+	}
 	var t Todo
-	row := r.db.QueryRowContext(ctx,
-		`SELECT id, owner, status, title, content, due_datetime, created_at, updated_at FROM todos WHERE id = ? AND owner = ?`,
-		id, owner,
-	)
+	row := q.QueryRowContext(ctx, query, id, owner)
 	if err := row.Scan(&t.ID, &t.Owner, &t.Status, &t.Title, &t.Content, &t.DueDatetime, &t.CreatedAt, &t.UpdatedAt); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return Todo{}, sql.ErrNoRows
@@ -45,32 +94,84 @@ func (r *TodoRepo) GetByIDOwner(ctx context.Context, id, owner string) (Todo, er
 	return t, nil
 }
 
-func (r *TodoRepo) UpdateByIDOwner(ctx context.Context, id, owner string, title, content, status *string, dueDatetime *time.Time) error {
-	t, err := r.GetByIDOwner(ctx, id, owner)
+// UpdateByIDOwner applies u on behalf of by. A status change is checked by u.Status.Allow against the current
+// status and recorded in todo_status_history, and any actual change is stored as a new todo_revisions row,
+// all atomically with the update.
+func (r *TodoRepo) UpdateByIDOwner(ctx context.Context, id, owner, by string, u TodoUpdate) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := r.update(ctx, tx, id, owner, by, u); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	r.reads.wrote(owner)
+	return nil
+}
+
+func (r *TodoRepo) update(ctx context.Context, tx *sql.Tx, id, owner, by string, u TodoUpdate) error {
+	old, err := r.get(ctx, tx, id, owner, true)
 	if err != nil {
 		return err
 	}
-	if title != nil {
-		t.Title = *title
+	t := old
+	if u.Title != nil {
+		t.Title = *u.Title
 	}
-	if content != nil {
-		t.Content = *content
+	if u.Content != nil {
+		t.Content = *u.Content
 	}
-	if status != nil {
-		t.Status = *status
+	if u.DueDatetime != nil {
+		t.DueDatetime = u.DueDatetime
+	} else if u.ClearDueDatetime {
+		t.DueDatetime = nil
 	}
-	if dueDatetime != nil {
-		t.DueDatetime = dueDatetime
+	if status := u.Status; status != nil && status.Status != old.Status {
+		if status.Allow != nil {
+			if err := status.Allow(old.Status); err != nil {
+				return err
+			}
+		}
+		t.Status = status.Status
+		if err := insertStatusHistory(ctx, tx, id, &old.Status, status.Status, status.Reason, by); err != nil {
+			return err
+		}
+	}
+	changed := changedFields(old, t)
+	if len(changed) == 0 {
+		return nil
 	}
-	_, err = r.db.ExecContext(ctx,
+	if err := insertRevision(ctx, tx, t, changed, by); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx,
 		`UPDATE todos SET status = ?, title = ?, content = ?, due_datetime = ? WHERE id = ? AND owner = ?`,
 		t.Status, t.Title, t.Content, t.DueDatetime, id, owner,
 	)
 	return err
 }
 
+func insertStatusHistory(ctx context.Context, tx *sql.Tx, todoID string, from *string, to, reason, by string) error {
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO todo_status_history (todo, from_status, to_status, reason, changed_by) VALUES (?, ?, ?, ?, ?)`,
+		todoID, from, to, nullIfEmpty(reason), by,
+	)
+	return err
+}
+
 func (r *TodoRepo) DeleteByIDOwner(ctx context.Context, id, owner string) error {
-	res, err := r.db.ExecContext(ctx, `DELETE FROM todos WHERE id = ? AND owner = ?`, id, owner)
+	err := deleteTodo(ctx, r.db, id, owner)
+	r.reads.wrote(owner)
+	return err
+}
+
+func deleteTodo(ctx context.Context, q queryer, id, owner string) error {
+	res, err := q.ExecContext(ctx, `DELETE FROM todos WHERE id = ? AND owner = ?`, id, owner)
 	if err != nil {
 		return err
 	}
@@ -82,7 +183,7 @@ func (r *TodoRepo) DeleteByIDOwner(ctx context.Context, id, owner string) error
 }
 
 func (r *TodoRepo) ListByOwner(ctx context.Context, owner string) ([]Todo, error) {
-	rows, err := r.db.QueryContext(ctx,
+	rows, err := r.reads.reader(owner).QueryContext(ctx,
 		`SELECT id, owner, status, title, content, due_datetime, created_at, updated_at
 		 FROM todos WHERE owner = ? ORDER BY created_at DESC`,
 		owner,
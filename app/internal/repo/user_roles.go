@@ -0,0 +1,32 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+)
+
+type UserRoleRepo struct {
+	db *sql.DB
+}
+
+// RolesByUID implements auth.RoleStore.
+func (r *UserRoleRepo) RolesByUID(ctx context.Context, uid string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT role FROM user_roles WHERE uid = ? ORDER BY role`, uid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []string
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, err
+		}
+		out = append(out, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -4,30 +4,56 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 )
 
 type User struct {
 	UID      string
 	Nickname string
-	Email    string
+	// Email is empty for anonymous users.
+	Email        string
+	Disabled     bool
+	IsAnonymous  bool
+	LastActiveAt time.Time
 }
 
 type UserRepo struct {
-	db *sql.DB
+	db    *sql.DB
+	reads *readRouter
+}
+
+const userColumns = `uid, nickname, email, disabled, is_anonymous, last_active_at`
+
+func scanUser(row rowScanner) (User, error) {
+	var u User
+	var email sql.NullString
+	if err := row.Scan(&u.UID, &u.Nickname, &email, &u.Disabled, &u.IsAnonymous, &u.LastActiveAt); err != nil {
+		return User{}, err
+	}
+	u.Email = email.String
+	return u, nil
 }
 
 func (r *UserRepo) Create(ctx context.Context, u User) error {
 	_, err := r.db.ExecContext(ctx,
-		`INSERT INTO users (uid, nickname, email) VALUES (?, ?, ?)`,
-		u.UID, u.Nickname, u.Email,
+		`INSERT INTO users (uid, nickname, email, is_anonymous) VALUES (?, ?, ?, ?)`,
+		u.UID, u.Nickname, nullIfEmpty(u.Email), u.IsAnonymous,
 	)
+	if err == nil {
+		r.reads.wrote(u.UID)
+	}
 	return err
 }
 
 func (r *UserRepo) GetByUID(ctx context.Context, uid string) (User, error) {
-	var u User
-	row := r.db.QueryRowContext(ctx, `SELECT uid, nickname, email FROM users WHERE uid = ?`, uid)
-	if err := row.Scan(&u.UID, &u.Nickname, &u.Email); err != nil {
+	return r.get(ctx, r.reads.reader(uid), uid)
+}
+
+// get reads from db explicitly; read-modify-write paths must use the primary.
+func (r *UserRepo) get(ctx context.Context, db *sql.DB, uid string) (User, error) {
+	row := db.QueryRowContext(ctx, `SELECT `+userColumns+` FROM users WHERE uid = ?`, uid)
+	u, err := scanUser(row)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return User{}, sql.ErrNoRows
 		}
@@ -38,7 +64,7 @@ func (r *UserRepo) GetByUID(ctx context.Context, uid string) (User, error) {
 
 func (r *UserRepo) Update(ctx context.Context, uid string, nickname *string, email *string) (User, error) {
 	// Fetch existing and apply partial updates
-	u, err := r.GetByUID(ctx, uid)
+	u, err := r.get(ctx, r.db, uid)
 	if err != nil {
 		return User{}, err
 	}
@@ -48,11 +74,128 @@ func (r *UserRepo) Update(ctx context.Context, uid string, nickname *string, ema
 	if email != nil {
 		u.Email = *email
 	}
-	_, err = r.db.ExecContext(ctx, `UPDATE users SET nickname = ?, email = ? WHERE uid = ?`, u.Nickname, u.Email, uid)
+	_, err = r.db.ExecContext(ctx, `UPDATE users SET nickname = ?, email = ? WHERE uid = ?`, u.Nickname, nullIfEmpty(u.Email), uid)
+	if err != nil {
+		return User{}, err
+	}
+	r.reads.wrote(uid)
+	return u, nil
+}
+
+// Upgrade turns an anonymous user into a regular one. The uid (and so every todo) is kept.
+func (r *UserRepo) Upgrade(ctx context.Context, uid, email string, nickname *string) (User, error) {
+	u, err := r.get(ctx, r.db, uid)
+	if err != nil {
+		return User{}, err
+	}
+	u.Email = email
+	u.IsAnonymous = false
+	if nickname != nil {
+		u.Nickname = *nickname
+	}
+	_, err = r.db.ExecContext(ctx,
+		`UPDATE users SET nickname = ?, email = ?, is_anonymous = 0 WHERE uid = ?`,
+		u.Nickname, u.Email, uid,
+	)
+	if err != nil {
+		return User{}, err
+	}
+	r.reads.wrote(uid)
+	return u, nil
+}
+
+// TouchActive bumps last_active_at, at most once per minute per user.
+func (r *UserRepo) TouchActive(ctx context.Context, uid string) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE users SET last_active_at = NOW()
+		 WHERE uid = ? AND last_active_at < NOW() - INTERVAL 1 MINUTE`,
+		uid,
+	)
+	return err
+}
+
+// ListInactiveAnonymous returns up to limit anonymous users not active since before.
+func (r *UserRepo) ListInactiveAnonymous(ctx context.Context, before time.Time, limit int) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT uid FROM users WHERE is_anonymous = 1 AND last_active_at < ? ORDER BY last_active_at LIMIT ?`,
+		before, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []string
+	for rows.Next() {
+		var uid string
+		if err := rows.Scan(&uid); err != nil {
+			return nil, err
+		}
+		out = append(out, uid)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Delete removes a user; todos, goodlucks and tokens go with it (ON DELETE CASCADE).
+func (r *UserRepo) Delete(ctx context.Context, uid string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM users WHERE uid = ?`, uid)
+	if err != nil {
+		return err
+	}
+	r.reads.wrote(uid)
+	n, _ := res.RowsAffected()
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *UserRepo) List(ctx context.Context, limit, offset int) ([]User, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT `+userColumns+` FROM users ORDER BY uid LIMIT ? OFFSET ?`,
+		limit, offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []User
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *UserRepo) SetDisabled(ctx context.Context, uid string, disabled bool) (User, error) {
+	u, err := r.get(ctx, r.db, uid)
 	if err != nil {
 		return User{}, err
 	}
+	if _, err := r.db.ExecContext(ctx, `UPDATE users SET disabled = ? WHERE uid = ?`, disabled, uid); err != nil {
+		return User{}, err
+	}
+	r.reads.wrote(uid)
+	u.Disabled = disabled
 	return u, nil
 }
 
+// nullIfEmpty stores "" as NULL (e.g. anonymous users' email, so the unique key doesn't collide).
+func nullIfEmpty(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
 
@@ -3,61 +3,213 @@ package main
 import (
 	"context"
 	"database/sql"
-	"log"
+	_ "embed"
+	"flag"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	_ "github.com/go-sql-driver/mysql"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 
+	"go-gin-webapi/internal/apidocs"
+	"go-gin-webapi/internal/apispec"
 	"go-gin-webapi/internal/auth"
 	"go-gin-webapi/internal/config"
+	"go-gin-webapi/internal/database"
 	"go-gin-webapi/internal/handler"
+	"go-gin-webapi/internal/health"
+	"go-gin-webapi/internal/i18n"
+	"go-gin-webapi/internal/jobs"
+	"go-gin-webapi/internal/logging"
+	"go-gin-webapi/internal/metrics"
+	"go-gin-webapi/internal/ratelimit"
 	"go-gin-webapi/internal/repo"
+	"go-gin-webapi/internal/tracing"
 	"go-gin-webapi/schemas"
 )
 
+// openapiYAML is the spec as written, served at /openapi.yaml. schemas.GetSwagger decodes the same document.
+//
+//go:embed openapi.yml
+var openapiYAML []byte
+
 func main() {
-	cfg := config.Load()
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "YAML config file; environment variables override it")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: go-gin-webapi [-config file] [devtoken <uid> | config print]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	args := flag.Args()
+
+	cfg, cfgErr := config.Load(*configPath)
+	if len(args) > 0 && args[0] == "config" {
+		if err := runConfig(cfg, cfgErr, args[1:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+	if cfgErr != nil {
+		fmt.Fprintf(os.Stderr, "config: %v\n", cfgErr)
+		os.Exit(1)
+	}
+
+	if len(args) > 0 && args[0] == "devtoken" {
+		if err := runDevToken(cfg, args[1:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+	if len(args) > 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	logger, err := logging.New(os.Stdout, cfg.Log)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "config: %v\n", err)
+		os.Exit(1)
+	}
+	slog.SetDefault(logger)
+	if !cfg.IsDevelopment() {
+		// gin's debug route dump is unstructured text.
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	if cfg.Auth.Bypass {
+		slog.Warn("!!! AUTH_BYPASS is enabled: Firebase ID tokens are NOT required and signed dev tokens are accepted. Never enable this outside local development. !!!", "env", cfg.Env)
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		fatal("tracing setup", err)
+	}
 
-	db, err := sql.Open("mysql", cfg.DB.DSN())
+	db, err := database.Open(context.Background(), cfg.DB.DSN(), cfg.DB)
 	if err != nil {
-		log.Fatalf("db open: %v", err)
+		fatal("db open", err)
 	}
 	defer db.Close()
+	metrics.RegisterDB(db, "main")
+
+	var replica *sql.DB
+	if cfg.DB.ReplicaDSN != "" {
+		replica, err = database.Open(context.Background(), cfg.DB.ReplicaDSN, cfg.DB)
+		if err != nil {
+			fatal("db replica open", err)
+		}
+		defer replica.Close()
+		metrics.RegisterDB(replica, "replica")
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	if err := db.PingContext(ctx); err != nil {
-		log.Fatalf("db ping: %v", err)
-	}
 
 	idtk := auth.NewIdentityToolkitClient(cfg.Firebase.APIKey)
 	fbAdmin, fbAdminErr := auth.NewFirebaseAdmin(ctx, cfg.Firebase)
+	if fbAdminErr != nil {
+		slog.Warn("firebase admin unavailable", "err", fbAdminErr)
+	}
+
+	spec, err := schemas.GetSwagger()
+	if err != nil {
+		fatal("load openapi spec", err)
+	}
+	const baseURL = "/api/v1"
+	ops := apispec.NewIndex(spec, baseURL)
+
+	repos := repo.New(db, replica, cfg.DB.ReplicaStickiness)
+	verifier := auth.NewVerifier(fbAdmin, fbAdminErr, cfg.Auth, repos.Roles, repos.Tokens)
+	h := handler.NewAPI(repos, idtk, fbAdmin, verifier)
+	if err := h.LoadTodoStatuses(ctx); err != nil {
+		fatal("load todo statuses", err)
+	}
 
-	repos := repo.New(db)
-	h := handler.NewAPI(repos, idtk, fbAdmin, fbAdminErr, cfg.Auth)
+	// NewStrictHandler wraps in list order, so the last middleware runs first: logging, rate limiting,
+	// then activity tracking. Authentication is a server middleware and has already run, so uid-keyed
+	// limits can see the principal.
+	middlewares := []schemas.StrictMiddlewareFunc{h.TrackActivity()}
+	if cfg.RateLimit.Enabled {
+		// config.Validate has already reported any error in the rules.
+		rules, _ := ratelimit.ParseRules(cfg.RateLimit.Rules, ratelimit.DefaultRules)
+		var store ratelimit.Store = ratelimit.NewMemoryStore()
+		if cfg.RateLimit.Store == "mysql" {
+			store = ratelimit.NewMySQLStore(db)
+		}
+		middlewares = append(middlewares, ratelimit.Middleware(store, rules, ops, auth.UID))
+	}
+	middlewares = append(middlewares, logging.StrictMiddleware())
 
 	r := gin.New()
-	r.Use(gin.Logger(), gin.Recovery())
+	// Strict handlers get the *gin.Context as their context.Context; let it reach the request's values (trace, log info).
+	r.ContextWithFallback = true
+	// otelgin goes first so the access log and error bodies carry the request's trace ID; i18n before
+	// anything that writes an error body.
+	r.Use(otelgin.Middleware(cfg.Tracing.ServiceName), i18n.Middleware(), logging.Middleware(logger), logging.Recovery(logger), metrics.Middleware(ops))
+	if mode := strings.ToLower(cfg.Validation.Responses); mode != "off" {
+		// Outside RespondErrors so the error bodies it writes are checked too.
+		r.Use(ops.ValidateResponses(mode == "fail", handler.InvalidResponse))
+	}
+	r.Use(handler.RespondErrors)
+	if err := r.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		fatal("invalid config: trusted proxies", err)
+	}
 
-	schemas.RegisterHandlersWithOptions(r, h, schemas.GinServerOptions{
-		BaseURL: "/api/v1",
+	schemas.RegisterHandlersWithOptions(r, schemas.NewStrictHandler(h, middlewares), schemas.GinServerOptions{
+		BaseURL: baseURL,
+		Middlewares: []schemas.MiddlewareFunc{
+			ops.LiteralColons(handler.NotFound),
+			// Authenticate before validating, so only callers with valid credentials get field errors.
+			auth.Middleware(verifier, ops),
+			ops.ValidateRequests(handler.InvalidRequest),
+			ops.OptionalBodies(),
+		},
 	})
 
-	// Optional swagger spec endpoint
-	r.GET("/swagger.json", func(c *gin.Context) {
-		spec, err := schemas.GetSwagger()
+	checks := []health.Check{
+		{Name: "db", Fn: db.PingContext},
+		{Name: "migrations", Fn: func(ctx context.Context) error {
+			v, err := repos.Schema.Version(ctx)
+			if err != nil {
+				return err
+			}
+			if v < repo.SchemaVersion {
+				return fmt.Errorf("schema version %d, want %d", v, repo.SchemaVersion)
+			}
+			return nil
+		}},
+	}
+	if replica != nil {
+		checks = append(checks, health.Check{Name: "db_replica", Fn: replica.PingContext})
+	}
+	if !cfg.Auth.Bypass {
+		// Dev tokens don't need Firebase Admin.
+		checks = append(checks, health.Check{Name: "firebase", Fn: func(context.Context) error { return fbAdminErr }})
+	}
+	hc := health.New(2*time.Second, checks...)
+	r.GET("/healthz", hc.Liveness)
+	r.GET("/readyz", hc.Readiness)
+
+	// Prometheus scrape endpoint. Keep it off the public ingress.
+	r.GET("/metrics", gin.WrapH(metrics.Handler()))
+
+	if cfg.Docs.Enabled {
+		docs, err := apidocs.New(spec, openapiYAML, baseURL)
 		if err != nil {
-			msg := err.Error()
-			c.JSON(http.StatusInternalServerError, schemas.InternalServerErrorJSONResponse{Error: &msg})
-			return
+			fatal("load openapi spec", err)
 		}
-		c.JSON(http.StatusOK, spec)
-	})
+		r.GET("/swagger.json", docs.JSON)
+		r.GET("/openapi.yaml", docs.YAML)
+		r.StaticFS("/docs", apidocs.UI())
+	}
 
 	srv := &http.Server{
 		Addr:              ":" + cfg.Port,
@@ -66,19 +218,46 @@ func main() {
 	}
 
 	go func() {
-		log.Printf("listening on :%s", cfg.Port)
+		slog.Info("listening", "addr", srv.Addr)
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("listen: %v", err)
+			fatal("listen", err)
 		}
 	}()
 
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	gc := &jobs.AnonymousGC{
+		Users:    repos.Users,
+		Firebase: fbAdmin,
+		Bypass:   cfg.Auth.Bypass,
+		MaxIdle:  cfg.Anonymous.MaxIdle,
+		Interval: cfg.Anonymous.GCInterval,
+	}
+	go gc.Run(jobsCtx)
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 	<-stop
 
+	// Fail readiness first so the load balancer stops sending traffic, then stop accepting connections.
+	hc.Drain()
+	slog.Info("draining", "delay", cfg.ShutdownDrainDelay)
+	time.Sleep(cfg.ShutdownDrainDelay)
+
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer shutdownCancel()
-	_ = srv.Shutdown(shutdownCtx)
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		slog.Error("shutdown", "err", err)
+	}
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Error("tracing shutdown", "err", err)
+	}
+}
+
+// fatal logs err and exits. Deferred calls don't run, same as log.Fatal.
+func fatal(msg string, err error) {
+	slog.Error(msg, "err", err)
+	os.Exit(1)
 }
 
 
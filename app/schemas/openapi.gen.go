@@ -14,6 +14,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
@@ -26,14 +27,124 @@ const (
 	BearerScopes = "bearer.Scopes"
 )
 
-// Defines values for TodoStatus.
+// Defines values for AccessTokenScope.
 const (
-	Empty TodoStatus = "未着手"
-	N1    TodoStatus = "進行中"
-	N2    TodoStatus = "完了"
-	N3    TodoStatus = "保留"
+	TodosRead  AccessTokenScope = "todos:read"
+	TodosWrite AccessTokenScope = "todos:write"
 )
 
+// Defines values for BatchTodoOperationOp.
+const (
+	Create BatchTodoOperationOp = "create"
+	Delete BatchTodoOperationOp = "delete"
+	Update BatchTodoOperationOp = "update"
+)
+
+// Defines values for JsonPatchOperationOp.
+const (
+	Add     JsonPatchOperationOp = "add"
+	Copy    JsonPatchOperationOp = "copy"
+	Move    JsonPatchOperationOp = "move"
+	Remove  JsonPatchOperationOp = "remove"
+	Replace JsonPatchOperationOp = "replace"
+	Test    JsonPatchOperationOp = "test"
+)
+
+// Defines values for TodoFieldChangeField.
+const (
+	Content     TodoFieldChangeField = "content"
+	DueDatetime TodoFieldChangeField = "due_datetime"
+	Status      TodoFieldChangeField = "status"
+	Title       TodoFieldChangeField = "title"
+)
+
+// AccessToken defines model for AccessToken.
+type AccessToken struct {
+	CreatedAt  *time.Time          `json:"created_at,omitempty"`
+	ExpiresAt  *time.Time          `json:"expires_at,omitempty"`
+	Id         *string             `json:"id,omitempty"`
+	LastUsedAt *time.Time          `json:"last_used_at,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+	Scopes     *[]AccessTokenScope `json:"scopes,omitempty"`
+}
+
+// AccessTokenListResponse defines model for AccessTokenListResponse.
+type AccessTokenListResponse = []AccessToken
+
+// AccessTokenScope アクセストークンのスコープ
+type AccessTokenScope string
+
+// AdminUser defines model for AdminUser.
+type AdminUser struct {
+	Disabled *bool                `json:"disabled,omitempty"`
+	Email    *openapi_types.Email `json:"email,omitempty"`
+	Nickname *string              `json:"nickname,omitempty"`
+	Uid      *string              `json:"uid,omitempty"`
+}
+
+// AdminUserListResponse defines model for AdminUserListResponse.
+type AdminUserListResponse = []AdminUser
+
+// BatchTodoOperation defines model for BatchTodoOperation.
+type BatchTodoOperation struct {
+	Create *CreateTodoRequest   `json:"create,omitempty"`
+	Op     BatchTodoOperationOp `json:"op"`
+
+	// TodoId update / delete の対象
+	TodoId *string            `json:"todo_id,omitempty"`
+	Update *UpdateTodoRequest `json:"update,omitempty"`
+}
+
+// BatchTodoOperationOp defines model for BatchTodoOperation.Op.
+type BatchTodoOperationOp string
+
+// BatchTodoRequest defines model for BatchTodoRequest.
+type BatchTodoRequest struct {
+	// Atomic true なら全件成功した場合のみ反映する
+	Atomic     *bool                `json:"atomic,omitempty"`
+	Operations []BatchTodoOperation `json:"operations"`
+}
+
+// BatchTodoResponse defines model for BatchTodoResponse.
+type BatchTodoResponse struct {
+	// Committed 変更が反映されたか
+	Committed *bool              `json:"committed,omitempty"`
+	Results   *[]BatchTodoResult `json:"results,omitempty"`
+}
+
+// BatchTodoResult defines model for BatchTodoResult.
+type BatchTodoResult struct {
+	// AllowedStatuses ステータス遷移が拒否された場合（409 / 422）の遷移可能なステータス
+	AllowedStatuses *[]TodoStatus `json:"allowed_statuses,omitempty"`
+	Error           *string       `json:"error,omitempty"`
+
+	// Id 対象（create の場合は作成された）TodoID
+	Id *string `json:"id,omitempty"`
+
+	// Status 個別の API を呼んだ場合の HTTP ステータスコード
+	Status *int `json:"status,omitempty"`
+}
+
+// CreateAccessTokenRequest defines model for CreateAccessTokenRequest.
+type CreateAccessTokenRequest struct {
+	// ExpiresAt 有効期限（省略時は無期限）
+	ExpiresAt *time.Time         `json:"expires_at,omitempty"`
+	Name      string             `json:"name"`
+	Scopes    []AccessTokenScope `json:"scopes"`
+}
+
+// CreateAccessTokenResponse defines model for CreateAccessTokenResponse.
+type CreateAccessTokenResponse struct {
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+	ExpiresAt *time.Time          `json:"expires_at,omitempty"`
+	Id        *string             `json:"id,omitempty"`
+	Name      *string             `json:"name,omitempty"`
+	Scopes    *[]AccessTokenScope `json:"scopes,omitempty"`
+
+	// Token トークン本体（このレスポンスでのみ返される）
+	Token *string `json:"token,omitempty"`
+}
+
 // CreateGoodluckRequest defines model for CreateGoodluckRequest.
 type CreateGoodluckRequest struct {
 	TodoId *string `json:"todo_id,omitempty"`
@@ -47,14 +158,22 @@ type CreateGoodluckResponse struct {
 
 // CreateTodoRequest defines model for CreateTodoRequest.
 type CreateTodoRequest struct {
-	Content *string `json:"content,omitempty"`
+	Content string `json:"content"`
 
 	// DueDatetime 期限日時（yyyy/mm/dd hh:mm）
 	DueDatetime *TodoDueDatetime `json:"due_datetime,omitempty"`
 
-	// Status Todoのステータス
+	// Status Todoのステータス（GET /todo-statuses で取得できる status のいずれか）
 	Status *TodoStatus `json:"status,omitempty"`
-	Title  *string     `json:"title,omitempty"`
+
+	// StatusCode 言語に依存しないステータスのコード（GET /todo-statuses で取得できる status_code のいずれか）。
+	// 既定のステータスは not_started（未着手）・in_progress（進行中）・done（完了）・on_hold（保留）。
+	// リクエストでは status の代わりに指定でき、両方指定する場合は同じステータスを指すこと。
+	StatusCode *TodoStatusCode `json:"status_code,omitempty"`
+
+	// StatusReason 初期ステータスの理由（既定以外のステータスで作成し、遷移ルールで必須の場合）
+	StatusReason *string `json:"status_reason,omitempty"`
+	Title        string  `json:"title"`
 }
 
 // CreateTodoResponse defines model for CreateTodoResponse.
@@ -62,6 +181,30 @@ type CreateTodoResponse struct {
 	Id *string `json:"id,omitempty"`
 }
 
+// CreateTodoStatusRequest defines model for CreateTodoStatusRequest.
+type CreateTodoStatusRequest struct {
+	IsTerminal *bool `json:"is_terminal,omitempty"`
+
+	// SortOrder 表示順（省略時は末尾）。新規Todoの既定値が変わらないよう、既定のステータスより大きい値にする
+	SortOrder *int   `json:"sort_order,omitempty"`
+	Status    string `json:"status"`
+
+	// StatusCode 言語に依存しないステータスのコード（GET /todo-statuses で取得できる status_code のいずれか）。
+	// 既定のステータスは not_started（未着手）・in_progress（進行中）・done（完了）・on_hold（保留）。
+	// リクエストでは status の代わりに指定でき、両方指定する場合は同じステータスを指すこと。
+	StatusCode *TodoStatusCode `json:"status_code,omitempty"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field 項目（入れ子はドット区切り。クエリ・パスパラメータはその名前）
+	Field   string `json:"field"`
+	Message string `json:"message"`
+
+	// Rule 満たしていない制約（required / maxLength / enum / pattern / format / not_blank など）
+	Rule string `json:"rule"`
+}
+
 // GetTodoDetailResponse defines model for GetTodoDetailResponse.
 type GetTodoDetailResponse struct {
 	Content *string `json:"content,omitempty"`
@@ -69,9 +212,14 @@ type GetTodoDetailResponse struct {
 	// DueDatetime 期限日時（yyyy/mm/dd hh:mm）
 	DueDatetime *TodoDueDatetime `json:"due_datetime,omitempty"`
 
-	// Status Todoのステータス
+	// Status Todoのステータス（GET /todo-statuses で取得できる status のいずれか）
 	Status *TodoStatus `json:"status,omitempty"`
-	Title  *string     `json:"title,omitempty"`
+
+	// StatusCode 言語に依存しないステータスのコード（GET /todo-statuses で取得できる status_code のいずれか）。
+	// 既定のステータスは not_started（未着手）・in_progress（進行中）・done（完了）・on_hold（保留）。
+	// リクエストでは status の代わりに指定でき、両方指定する場合は同じステータスを指すこと。
+	StatusCode *TodoStatusCode `json:"status_code,omitempty"`
+	Title      *string         `json:"title,omitempty"`
 }
 
 // GetTodoListResponse defines model for GetTodoListResponse.
@@ -80,21 +228,46 @@ type GetTodoListResponse = []struct {
 	DueDatetime *TodoDueDatetime `json:"due_datetime,omitempty"`
 	Id          *string          `json:"id,omitempty"`
 
-	// Status Todoのステータス
+	// Status Todoのステータス（GET /todo-statuses で取得できる status のいずれか）
 	Status *TodoStatus `json:"status,omitempty"`
-	Title  *string     `json:"title,omitempty"`
+
+	// StatusCode 言語に依存しないステータスのコード（GET /todo-statuses で取得できる status_code のいずれか）。
+	// 既定のステータスは not_started（未着手）・in_progress（進行中）・done（完了）・on_hold（保留）。
+	// リクエストでは status の代わりに指定でき、両方指定する場合は同じステータスを指すこと。
+	StatusCode *TodoStatusCode `json:"status_code,omitempty"`
+	Title      *string         `json:"title,omitempty"`
 }
 
 // GetUserDetailResponse defines model for GetUserDetailResponse.
 type GetUserDetailResponse struct {
-	Email    *openapi_types.Email `json:"email,omitempty"`
-	Nickname *string              `json:"nickname,omitempty"`
+	Email       *openapi_types.Email `json:"email,omitempty"`
+	IsAnonymous *bool                `json:"is_anonymous,omitempty"`
+	Nickname    *string              `json:"nickname,omitempty"`
+}
+
+// JsonPatch defines model for JsonPatch.
+type JsonPatch = json.RawMessage
+
+// JsonPatchOperation defines model for JsonPatchOperation.
+type JsonPatchOperation struct {
+	// From move / copy の元
+	From *string              `json:"from,omitempty"`
+	Op   JsonPatchOperationOp `json:"op"`
+
+	// Path JSON Pointer（例: /due_datetime）
+	Path string `json:"path"`
+
+	// Value add / replace / test の値
+	Value interface{} `json:"value,omitempty"`
 }
 
+// JsonPatchOperationOp defines model for JsonPatchOperation.Op.
+type JsonPatchOperationOp string
+
 // LoginUserRequest defines model for LoginUserRequest.
 type LoginUserRequest struct {
-	Email    *openapi_types.Email `json:"email,omitempty"`
-	Password *string              `json:"password,omitempty"`
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
 }
 
 // LoginUserResponse defines model for LoginUserResponse.
@@ -106,7 +279,7 @@ type LoginUserResponse struct {
 
 // LogoutUserRequest defines model for LogoutUserRequest.
 type LogoutUserRequest struct {
-	UserId *string `json:"user_id,omitempty"`
+	UserId string `json:"user_id"`
 }
 
 // LogoutUserResponse defines model for LogoutUserResponse.
@@ -114,11 +287,61 @@ type LogoutUserResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// Problem エラーレスポンス（RFC 7807 application/problem+json）
+type Problem struct {
+	// Code 機械処理用の安定したエラーコード（validation_failed / not_found / email_taken など）
+	Code string `json:"code"`
+
+	// Detail 今回の発生についての説明
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors 項目ごとの検証エラー（code が validation_failed の場合）
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Instance 問題が起きたリクエストのパス
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTP ステータスコード
+	Status int `json:"status"`
+
+	// Title 問題の種類の要約（code と言語が同じなら同じ文言）
+	Title string `json:"title"`
+
+	// TraceId トレースID（問い合わせ・ログ検索用）。サーバーエラーはこの ID でログに記録され、原因はレスポンスに含めない
+	TraceId *string `json:"trace_id,omitempty"`
+
+	// Type 問題の種類を表す URI（code ごとに一意。参照先は無い）
+	Type string `json:"type"`
+}
+
+// RefreshTokenRequest defines model for RefreshTokenRequest.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RefreshTokenResponse defines model for RefreshTokenResponse.
+type RefreshTokenResponse struct {
+	AccessToken *string `json:"access_token,omitempty"`
+
+	// ExpiresIn access_token の有効期間（秒）
+	ExpiresIn    *int    `json:"expires_in,omitempty"`
+	RefreshToken *string `json:"refresh_token,omitempty"`
+	Uid          *string `json:"uid,omitempty"`
+}
+
+// RegisterAnonymousUserResponse defines model for RegisterAnonymousUserResponse.
+type RegisterAnonymousUserResponse struct {
+	AccessToken  *string `json:"access_token,omitempty"`
+	Nickname     *string `json:"nickname,omitempty"`
+	RefreshToken *string `json:"refresh_token,omitempty"`
+	Uid          *string `json:"uid,omitempty"`
+}
+
 // RegisterUserRequest defines model for RegisterUserRequest.
 type RegisterUserRequest struct {
-	Email    *openapi_types.Email `json:"email,omitempty"`
-	Nickname *string              `json:"nickname,omitempty"`
-	Password *string              `json:"password,omitempty"`
+	Email    openapi_types.Email `json:"email"`
+	Nickname string              `json:"nickname"`
+	Password string              `json:"password"`
 }
 
 // RegisterUserResponse defines model for RegisterUserResponse.
@@ -128,11 +351,121 @@ type RegisterUserResponse struct {
 	Uid          *string `json:"uid,omitempty"`
 }
 
+// RevertTodoRequest defines model for RevertTodoRequest.
+type RevertTodoRequest struct {
+	// StatusReason ステータスが変わる場合の理由（遷移ルールで必須の場合あり）
+	StatusReason *string `json:"status_reason,omitempty"`
+}
+
+// StatusTransitionError defines model for StatusTransitionError.
+type StatusTransitionError struct {
+	// AllowedStatuses 現在のステータスから遷移できるステータス
+	AllowedStatuses *[]TodoStatus `json:"allowed_statuses,omitempty"`
+
+	// Code 機械処理用の安定したエラーコード（validation_failed / not_found / email_taken など）
+	Code string `json:"code"`
+
+	// Detail 今回の発生についての説明
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors 項目ごとの検証エラー（code が validation_failed の場合）
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Instance 問題が起きたリクエストのパス
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTP ステータスコード
+	Status int `json:"status"`
+
+	// Title 問題の種類の要約（code と言語が同じなら同じ文言）
+	Title string `json:"title"`
+
+	// TraceId トレースID（問い合わせ・ログ検索用）。サーバーエラーはこの ID でログに記録され、原因はレスポンスに含めない
+	TraceId *string `json:"trace_id,omitempty"`
+
+	// Type 問題の種類を表す URI（code ごとに一意。参照先は無い）
+	Type string `json:"type"`
+}
+
 // TodoDueDatetime 期限日時（yyyy/mm/dd hh:mm）
 type TodoDueDatetime = string
 
-// TodoStatus Todoのステータス
-type TodoStatus string
+// TodoFieldChange defines model for TodoFieldChange.
+type TodoFieldChange struct {
+	Field *TodoFieldChangeField `json:"field,omitempty"`
+
+	// From 変更前の値（作成時・未設定は null）
+	From *string `json:"from"`
+
+	// To 変更後の値（未設定は null）
+	To *string `json:"to"`
+}
+
+// TodoFieldChangeField defines model for TodoFieldChange.Field.
+type TodoFieldChangeField string
+
+// TodoHistoryResponse defines model for TodoHistoryResponse.
+type TodoHistoryResponse = []TodoRevision
+
+// TodoMergePatch defines model for TodoMergePatch.
+type TodoMergePatch = json.RawMessage
+
+// TodoRevision defines model for TodoRevision.
+type TodoRevision struct {
+	ChangedAt *time.Time `json:"changed_at,omitempty"`
+
+	// ChangedBy 変更したユーザーの uid
+	ChangedBy *string            `json:"changed_by,omitempty"`
+	Changes   *[]TodoFieldChange `json:"changes,omitempty"`
+
+	// Rev 版（1 から連番）
+	Rev *int `json:"rev,omitempty"`
+}
+
+// TodoStatus Todoのステータス（GET /todo-statuses で取得できる status のいずれか）
+type TodoStatus = string
+
+// TodoStatusCode 言語に依存しないステータスのコード（GET /todo-statuses で取得できる status_code のいずれか）。
+// 既定のステータスは not_started（未着手）・in_progress（進行中）・done（完了）・on_hold（保留）。
+// リクエストでは status の代わりに指定でき、両方指定する場合は同じステータスを指すこと。
+type TodoStatusCode = string
+
+// TodoStatusInfo defines model for TodoStatusInfo.
+type TodoStatusInfo struct {
+	// IsTerminal 終了状態か（完了など）
+	IsTerminal *bool `json:"is_terminal,omitempty"`
+
+	// SortOrder 表示順（最小のものが新規Todoの既定値）
+	SortOrder *int `json:"sort_order,omitempty"`
+
+	// Status Todoのステータス（GET /todo-statuses で取得できる status のいずれか）
+	Status *TodoStatus `json:"status,omitempty"`
+
+	// StatusCode 言語に依存しないステータスのコード（GET /todo-statuses で取得できる status_code のいずれか）。
+	// 既定のステータスは not_started（未着手）・in_progress（進行中）・done（完了）・on_hold（保留）。
+	// リクエストでは status の代わりに指定でき、両方指定する場合は同じステータスを指すこと。
+	StatusCode *TodoStatusCode `json:"status_code,omitempty"`
+}
+
+// TodoStatusListResponse defines model for TodoStatusListResponse.
+type TodoStatusListResponse = []TodoStatusInfo
+
+// TodoStatusTransition defines model for TodoStatusTransition.
+type TodoStatusTransition struct {
+	// From Todoのステータス（GET /todo-statuses で取得できる status のいずれか）
+	From *TodoStatus `json:"from,omitempty"`
+
+	// RequiresReason この遷移に status_reason が必要か
+	RequiresReason *bool `json:"requires_reason,omitempty"`
+
+	// To Todoのステータス（GET /todo-statuses で取得できる status のいずれか）
+	To *TodoStatus `json:"to,omitempty"`
+}
+
+// TodoWorkflow defines model for TodoWorkflow.
+type TodoWorkflow struct {
+	Transitions *[]TodoStatusTransition `json:"transitions,omitempty"`
+}
 
 // UpdateTodoRequest defines model for UpdateTodoRequest.
 type UpdateTodoRequest struct {
@@ -141,9 +474,17 @@ type UpdateTodoRequest struct {
 	// DueDatetime 期限日時（yyyy/mm/dd hh:mm）
 	DueDatetime *TodoDueDatetime `json:"due_datetime,omitempty"`
 
-	// Status Todoのステータス
+	// Status Todoのステータス（GET /todo-statuses で取得できる status のいずれか）
 	Status *TodoStatus `json:"status,omitempty"`
-	Title  *string     `json:"title,omitempty"`
+
+	// StatusCode 言語に依存しないステータスのコード（GET /todo-statuses で取得できる status_code のいずれか）。
+	// 既定のステータスは not_started（未着手）・in_progress（進行中）・done（完了）・on_hold（保留）。
+	// リクエストでは status の代わりに指定でき、両方指定する場合は同じステータスを指すこと。
+	StatusCode *TodoStatusCode `json:"status_code,omitempty"`
+
+	// StatusReason ステータス変更の理由（遷移ルールで必須の場合あり）
+	StatusReason *string `json:"status_reason,omitempty"`
+	Title        *string `json:"title,omitempty"`
 }
 
 // UpdateTodoResponse defines model for UpdateTodoResponse.
@@ -163,37 +504,80 @@ type UpdateUserResponse struct {
 	Nickname *string              `json:"nickname,omitempty"`
 }
 
+// UpgradeUserRequest defines model for UpgradeUserRequest.
+type UpgradeUserRequest struct {
+	Email    openapi_types.Email `json:"email"`
+	Nickname *string             `json:"nickname,omitempty"`
+	Password string              `json:"password"`
+}
+
+// UpgradeUserResponse defines model for UpgradeUserResponse.
+type UpgradeUserResponse struct {
+	AccessToken  *string `json:"access_token,omitempty"`
+	RefreshToken *string `json:"refresh_token,omitempty"`
+	Uid          *string `json:"uid,omitempty"`
+}
+
+// UserMergePatch defines model for UserMergePatch.
+type UserMergePatch = json.RawMessage
+
+// Limit defines model for limit.
+type Limit = int
+
+// Offset defines model for offset.
+type Offset = int
+
+// Rev defines model for rev.
+type Rev = int
+
 // TodoId defines model for todo_id.
 type TodoId = string
 
+// TokenId defines model for token_id.
+type TokenId = string
+
 // UserId defines model for user_id.
 type UserId = string
 
-// BadRequest defines model for BadRequest.
-type BadRequest struct {
-	Error *string `json:"error,omitempty"`
-}
+// BadRequest エラーレスポンス（RFC 7807 application/problem+json）
+type BadRequest = Problem
 
-// Forbidden defines model for Forbidden.
-type Forbidden struct {
-	Error *string `json:"error,omitempty"`
-}
+// Forbidden エラーレスポンス（RFC 7807 application/problem+json）
+type Forbidden = Problem
 
-// InternalServerError defines model for InternalServerError.
-type InternalServerError struct {
-	Error *string `json:"error,omitempty"`
-}
+// InternalServerError エラーレスポンス（RFC 7807 application/problem+json）
+type InternalServerError = Problem
 
-// NotFound defines model for NotFound.
-type NotFound struct {
-	Error *string `json:"error,omitempty"`
-}
+// NotFound エラーレスポンス（RFC 7807 application/problem+json）
+type NotFound = Problem
 
-// Unauthorized defines model for Unauthorized.
-type Unauthorized struct {
-	Error *string `json:"error,omitempty"`
+// PatchTestFailed エラーレスポンス（RFC 7807 application/problem+json）
+type PatchTestFailed = Problem
+
+// StatusReasonRequired defines model for StatusReasonRequired.
+type StatusReasonRequired = StatusTransitionError
+
+// StatusTransitionNotAllowed defines model for StatusTransitionNotAllowed.
+type StatusTransitionNotAllowed = StatusTransitionError
+
+// TooManyRequests エラーレスポンス（RFC 7807 application/problem+json）
+type TooManyRequests = Problem
+
+// Unauthorized エラーレスポンス（RFC 7807 application/problem+json）
+type Unauthorized = Problem
+
+// UnsupportedMediaType エラーレスポンス（RFC 7807 application/problem+json）
+type UnsupportedMediaType = Problem
+
+// GetAdminUsersParams defines parameters for GetAdminUsers.
+type GetAdminUsersParams struct {
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// PostAdminTodoStatusesJSONRequestBody defines body for PostAdminTodoStatuses for application/json ContentType.
+type PostAdminTodoStatusesJSONRequestBody = CreateTodoStatusRequest
+
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody = LoginUserRequest
 
@@ -203,20 +587,65 @@ type PostLogoutJSONRequestBody = LogoutUserRequest
 // PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
 type PostRegisterJSONRequestBody = RegisterUserRequest
 
+// PostTokenJSONRequestBody defines body for PostToken for application/json ContentType.
+type PostTokenJSONRequestBody = RefreshTokenRequest
+
+// PatchUsersUserIdApplicationJSONPatchPlusJSONRequestBody defines body for PatchUsersUserId for application/json-patch+json ContentType.
+type PatchUsersUserIdApplicationJSONPatchPlusJSONRequestBody = JsonPatch
+
+// PatchUsersUserIdApplicationMergePatchPlusJSONRequestBody defines body for PatchUsersUserId for application/merge-patch+json ContentType.
+type PatchUsersUserIdApplicationMergePatchPlusJSONRequestBody = UserMergePatch
+
 // PutUsersUserIdJSONRequestBody defines body for PutUsersUserId for application/json ContentType.
 type PutUsersUserIdJSONRequestBody = UpdateUserRequest
 
 // PostUsersUserIdTodosJSONRequestBody defines body for PostUsersUserIdTodos for application/json ContentType.
 type PostUsersUserIdTodosJSONRequestBody = CreateTodoRequest
 
+// PatchUsersUserIdTodosTodoIdApplicationJSONPatchPlusJSONRequestBody defines body for PatchUsersUserIdTodosTodoId for application/json-patch+json ContentType.
+type PatchUsersUserIdTodosTodoIdApplicationJSONPatchPlusJSONRequestBody = JsonPatch
+
+// PatchUsersUserIdTodosTodoIdApplicationMergePatchPlusJSONRequestBody defines body for PatchUsersUserIdTodosTodoId for application/merge-patch+json ContentType.
+type PatchUsersUserIdTodosTodoIdApplicationMergePatchPlusJSONRequestBody = TodoMergePatch
+
 // PutUsersUserIdTodosTodoIdJSONRequestBody defines body for PutUsersUserIdTodosTodoId for application/json ContentType.
 type PutUsersUserIdTodosTodoIdJSONRequestBody = UpdateTodoRequest
 
 // PostUsersUserIdTodosTodoIdGoodlucksJSONRequestBody defines body for PostUsersUserIdTodosTodoIdGoodlucks for application/json ContentType.
 type PostUsersUserIdTodosTodoIdGoodlucksJSONRequestBody = CreateGoodluckRequest
 
+// PostUsersUserIdTodosTodoIdHistoryRevRevertJSONRequestBody defines body for PostUsersUserIdTodosTodoIdHistoryRevRevert for application/json ContentType.
+type PostUsersUserIdTodosTodoIdHistoryRevRevertJSONRequestBody = RevertTodoRequest
+
+// PostUsersUserIdTodosBatchJSONRequestBody defines body for PostUsersUserIdTodosBatch for application/json ContentType.
+type PostUsersUserIdTodosBatchJSONRequestBody = BatchTodoRequest
+
+// PostUsersUserIdTokensJSONRequestBody defines body for PostUsersUserIdTokens for application/json ContentType.
+type PostUsersUserIdTokensJSONRequestBody = CreateAccessTokenRequest
+
+// PostUsersUserIdUpgradeJSONRequestBody defines body for PostUsersUserIdUpgrade for application/json ContentType.
+type PostUsersUserIdUpgradeJSONRequestBody = UpgradeUserRequest
+
+// PutUsersUserIdWorkflowJSONRequestBody defines body for PutUsersUserIdWorkflow for application/json ContentType.
+type PutUsersUserIdWorkflowJSONRequestBody = TodoWorkflow
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Todoステータス追加（管理者）
+	// (POST /admin/todo-statuses)
+	PostAdminTodoStatuses(c *gin.Context)
+	// ユーザー一覧取得（管理者）
+	// (GET /admin/users)
+	GetAdminUsers(c *gin.Context, params GetAdminUsersParams)
+	// ユーザー無効化（管理者）
+	// (POST /admin/users/{user_id}/disable)
+	PostAdminUsersUserIdDisable(c *gin.Context, userId UserId)
+	// ユーザー有効化（管理者）
+	// (POST /admin/users/{user_id}/enable)
+	PostAdminUsersUserIdEnable(c *gin.Context, userId UserId)
+	// ユーザーのTodo一覧取得（管理者）
+	// (GET /admin/users/{user_id}/todos)
+	GetAdminUsersUserIdTodos(c *gin.Context, userId UserId)
 	// ログイン
 	// (POST /login)
 	PostLogin(c *gin.Context)
@@ -226,9 +655,21 @@ type ServerInterface interface {
 	// ユーザー登録
 	// (POST /register)
 	PostRegister(c *gin.Context)
+	// 匿名ユーザー登録
+	// (POST /register/anonymous)
+	PostRegisterAnonymous(c *gin.Context)
+	// Todoステータス一覧取得
+	// (GET /todo-statuses)
+	GetTodoStatuses(c *gin.Context)
+	// アクセストークン再発行
+	// (POST /token)
+	PostToken(c *gin.Context)
 	// ユーザー詳細取得
 	// (GET /users/{user_id})
 	GetUsersUserId(c *gin.Context, userId UserId)
+	// ユーザー情報部分更新
+	// (PATCH /users/{user_id})
+	PatchUsersUserId(c *gin.Context, userId UserId)
 	// ユーザー情報編集
 	// (PUT /users/{user_id})
 	PutUsersUserId(c *gin.Context, userId UserId)
@@ -244,6 +685,9 @@ type ServerInterface interface {
 	// Todo詳細取得
 	// (GET /users/{user_id}/todos/{todo_id})
 	GetUsersUserIdTodosTodoId(c *gin.Context, userId UserId, todoId TodoId)
+	// Todo部分更新
+	// (PATCH /users/{user_id}/todos/{todo_id})
+	PatchUsersUserIdTodosTodoId(c *gin.Context, userId UserId, todoId TodoId)
 	// Todo編集
 	// (PUT /users/{user_id}/todos/{todo_id})
 	PutUsersUserIdTodosTodoId(c *gin.Context, userId UserId, todoId TodoId)
@@ -253,6 +697,33 @@ type ServerInterface interface {
 	// いいね作成
 	// (POST /users/{user_id}/todos/{todo_id}/goodlucks)
 	PostUsersUserIdTodosTodoIdGoodlucks(c *gin.Context, userId UserId, todoId TodoId)
+	// Todo変更履歴取得
+	// (GET /users/{user_id}/todos/{todo_id}/history)
+	GetUsersUserIdTodosTodoIdHistory(c *gin.Context, userId UserId, todoId TodoId)
+	// Todoを過去の版に戻す
+	// (POST /users/{user_id}/todos/{todo_id}/history/{rev}/revert)
+	PostUsersUserIdTodosTodoIdHistoryRevRevert(c *gin.Context, userId UserId, todoId TodoId, rev Rev)
+	// Todo一括操作
+	// (POST /users/{user_id}/todos:batch)
+	PostUsersUserIdTodosBatch(c *gin.Context, userId UserId)
+	// アクセストークン一覧取得
+	// (GET /users/{user_id}/tokens)
+	GetUsersUserIdTokens(c *gin.Context, userId UserId)
+	// アクセストークン作成
+	// (POST /users/{user_id}/tokens)
+	PostUsersUserIdTokens(c *gin.Context, userId UserId)
+	// アクセストークン失効
+	// (DELETE /users/{user_id}/tokens/{token_id})
+	DeleteUsersUserIdTokensTokenId(c *gin.Context, userId UserId, tokenId TokenId)
+	// 匿名ユーザー本登録
+	// (POST /users/{user_id}/upgrade)
+	PostUsersUserIdUpgrade(c *gin.Context, userId UserId)
+	// ワークフロー取得
+	// (GET /users/{user_id}/workflow)
+	GetUsersUserIdWorkflow(c *gin.Context, userId UserId)
+	// ワークフロー設定
+	// (PUT /users/{user_id}/workflow)
+	PutUsersUserIdWorkflow(c *gin.Context, userId UserId)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -264,8 +735,10 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// PostLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
+// PostAdminTodoStatuses operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminTodoStatuses(c *gin.Context) {
+
+	c.Set(BearerScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -274,26 +747,34 @@ func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostLogin(c)
+	siw.Handler.PostAdminTodoStatuses(c)
 }
 
-// PostLogout operation middleware
-func (siw *ServerInterfaceWrapper) PostLogout(c *gin.Context) {
+// GetAdminUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminUsers(c *gin.Context) {
+
+	var err error
 
 	c.Set(BearerScopes, []string{})
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminUsersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.PostLogout(c)
-}
+	// ------------- Optional query parameter "offset" -------------
 
-// PostRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -302,11 +783,11 @@ func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostRegister(c)
+	siw.Handler.GetAdminUsers(c, params)
 }
 
-// GetUsersUserId operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersUserId(c *gin.Context) {
+// PostAdminUsersUserIdDisable operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersUserIdDisable(c *gin.Context) {
 
 	var err error
 
@@ -328,11 +809,11 @@ func (siw *ServerInterfaceWrapper) GetUsersUserId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetUsersUserId(c, userId)
+	siw.Handler.PostAdminUsersUserIdDisable(c, userId)
 }
 
-// PutUsersUserId operation middleware
-func (siw *ServerInterfaceWrapper) PutUsersUserId(c *gin.Context) {
+// PostAdminUsersUserIdEnable operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersUserIdEnable(c *gin.Context) {
 
 	var err error
 
@@ -354,11 +835,11 @@ func (siw *ServerInterfaceWrapper) PutUsersUserId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutUsersUserId(c, userId)
+	siw.Handler.PostAdminUsersUserIdEnable(c, userId)
 }
 
-// GetUsersUserIdTodos operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersUserIdTodos(c *gin.Context) {
+// GetAdminUsersUserIdTodos operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminUsersUserIdTodos(c *gin.Context) {
 
 	var err error
 
@@ -380,22 +861,24 @@ func (siw *ServerInterfaceWrapper) GetUsersUserIdTodos(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetUsersUserIdTodos(c, userId)
+	siw.Handler.GetAdminUsersUserIdTodos(c, userId)
 }
 
-// PostUsersUserIdTodos operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersUserIdTodos(c *gin.Context) {
+// PostLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
 
-	var err error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Path parameter "user_id" -------------
-	var userId UserId
+	siw.Handler.PostLogin(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
-		return
-	}
+// PostLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostLogout(c *gin.Context) {
 
 	c.Set(BearerScopes, []string{})
 
@@ -406,33 +889,50 @@ func (siw *ServerInterfaceWrapper) PostUsersUserIdTodos(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostUsersUserIdTodos(c, userId)
+	siw.Handler.PostLogout(c)
 }
 
-// DeleteUsersUserIdTodosTodoId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteUsersUserIdTodosTodoId(c *gin.Context) {
+// PostRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
 
-	var err error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Path parameter "user_id" -------------
-	var userId UserId
+	siw.Handler.PostRegister(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
-		return
+// PostRegisterAnonymous operation middleware
+func (siw *ServerInterfaceWrapper) PostRegisterAnonymous(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Path parameter "todo_id" -------------
-	var todoId TodoId
+	siw.Handler.PostRegisterAnonymous(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", c.Param("todo_id"), &todoId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter todo_id: %w", err), http.StatusBadRequest)
-		return
+// GetTodoStatuses operation middleware
+func (siw *ServerInterfaceWrapper) GetTodoStatuses(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	c.Set(BearerScopes, []string{})
+	siw.Handler.GetTodoStatuses(c)
+}
+
+// PostToken operation middleware
+func (siw *ServerInterfaceWrapper) PostToken(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -441,11 +941,11 @@ func (siw *ServerInterfaceWrapper) DeleteUsersUserIdTodosTodoId(c *gin.Context)
 		}
 	}
 
-	siw.Handler.DeleteUsersUserIdTodosTodoId(c, userId, todoId)
+	siw.Handler.PostToken(c)
 }
 
-// GetUsersUserIdTodosTodoId operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersUserIdTodosTodoId(c *gin.Context) {
+// GetUsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersUserId(c *gin.Context) {
 
 	var err error
 
@@ -458,12 +958,29 @@ func (siw *ServerInterfaceWrapper) GetUsersUserIdTodosTodoId(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "todo_id" -------------
-	var todoId TodoId
+	c.Set(BearerScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", c.Param("todo_id"), &todoId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersUserId(c, userId)
+}
+
+// PatchUsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) PatchUsersUserId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter todo_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -476,11 +993,11 @@ func (siw *ServerInterfaceWrapper) GetUsersUserIdTodosTodoId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetUsersUserIdTodosTodoId(c, userId, todoId)
+	siw.Handler.PatchUsersUserId(c, userId)
 }
 
-// PutUsersUserIdTodosTodoId operation middleware
-func (siw *ServerInterfaceWrapper) PutUsersUserIdTodosTodoId(c *gin.Context) {
+// PutUsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) PutUsersUserId(c *gin.Context) {
 
 	var err error
 
@@ -493,12 +1010,29 @@ func (siw *ServerInterfaceWrapper) PutUsersUserIdTodosTodoId(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "todo_id" -------------
-	var todoId TodoId
+	c.Set(BearerScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", c.Param("todo_id"), &todoId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutUsersUserId(c, userId)
+}
+
+// GetUsersUserIdTodos operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersUserIdTodos(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter todo_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -511,11 +1045,37 @@ func (siw *ServerInterfaceWrapper) PutUsersUserIdTodosTodoId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutUsersUserIdTodosTodoId(c, userId, todoId)
+	siw.Handler.GetUsersUserIdTodos(c, userId)
 }
 
-// DeleteUsersUserIdTodosTodoIdGoodlucks operation middleware
-func (siw *ServerInterfaceWrapper) DeleteUsersUserIdTodosTodoIdGoodlucks(c *gin.Context) {
+// PostUsersUserIdTodos operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUserIdTodos(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersUserIdTodos(c, userId)
+}
+
+// DeleteUsersUserIdTodosTodoId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersUserIdTodosTodoId(c *gin.Context) {
 
 	var err error
 
@@ -546,11 +1106,11 @@ func (siw *ServerInterfaceWrapper) DeleteUsersUserIdTodosTodoIdGoodlucks(c *gin.
 		}
 	}
 
-	siw.Handler.DeleteUsersUserIdTodosTodoIdGoodlucks(c, userId, todoId)
+	siw.Handler.DeleteUsersUserIdTodosTodoId(c, userId, todoId)
 }
 
-// PostUsersUserIdTodosTodoIdGoodlucks operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersUserIdTodosTodoIdGoodlucks(c *gin.Context) {
+// GetUsersUserIdTodosTodoId operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersUserIdTodosTodoId(c *gin.Context) {
 
 	var err error
 
@@ -581,830 +1141,3375 @@ func (siw *ServerInterfaceWrapper) PostUsersUserIdTodosTodoIdGoodlucks(c *gin.Co
 		}
 	}
 
-	siw.Handler.PostUsersUserIdTodosTodoIdGoodlucks(c, userId, todoId)
+	siw.Handler.GetUsersUserIdTodosTodoId(c, userId, todoId)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// PatchUsersUserIdTodosTodoId operation middleware
+func (siw *ServerInterfaceWrapper) PatchUsersUserIdTodosTodoId(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
-	}
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	router.POST(options.BaseURL+"/login", wrapper.PostLogin)
-	router.POST(options.BaseURL+"/logout", wrapper.PostLogout)
-	router.POST(options.BaseURL+"/register", wrapper.PostRegister)
-	router.GET(options.BaseURL+"/users/:user_id", wrapper.GetUsersUserId)
-	router.PUT(options.BaseURL+"/users/:user_id", wrapper.PutUsersUserId)
-	router.GET(options.BaseURL+"/users/:user_id/todos", wrapper.GetUsersUserIdTodos)
-	router.POST(options.BaseURL+"/users/:user_id/todos", wrapper.PostUsersUserIdTodos)
-	router.DELETE(options.BaseURL+"/users/:user_id/todos/:todo_id", wrapper.DeleteUsersUserIdTodosTodoId)
-	router.GET(options.BaseURL+"/users/:user_id/todos/:todo_id", wrapper.GetUsersUserIdTodosTodoId)
-	router.PUT(options.BaseURL+"/users/:user_id/todos/:todo_id", wrapper.PutUsersUserIdTodosTodoId)
-	router.DELETE(options.BaseURL+"/users/:user_id/todos/:todo_id/goodlucks", wrapper.DeleteUsersUserIdTodosTodoIdGoodlucks)
-	router.POST(options.BaseURL+"/users/:user_id/todos/:todo_id/goodlucks", wrapper.PostUsersUserIdTodosTodoIdGoodlucks)
-}
+	// ------------- Path parameter "todo_id" -------------
+	var todoId TodoId
 
-type BadRequestJSONResponse struct {
-	Error *string `json:"error,omitempty"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", c.Param("todo_id"), &todoId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter todo_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ForbiddenJSONResponse struct {
-	Error *string `json:"error,omitempty"`
-}
+	c.Set(BearerScopes, []string{})
 
-type InternalServerErrorJSONResponse struct {
-	Error *string `json:"error,omitempty"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type NotFoundJSONResponse struct {
-	Error *string `json:"error,omitempty"`
+	siw.Handler.PatchUsersUserIdTodosTodoId(c, userId, todoId)
 }
 
-type UnauthorizedJSONResponse struct {
-	Error *string `json:"error,omitempty"`
-}
+// PutUsersUserIdTodosTodoId operation middleware
+func (siw *ServerInterfaceWrapper) PutUsersUserIdTodosTodoId(c *gin.Context) {
 
-type PostLoginRequestObject struct {
-	Body *PostLoginJSONRequestBody
-}
+	var err error
 
-type PostLoginResponseObject interface {
-	VisitPostLoginResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
 
-type PostLogin201JSONResponse LoginUserResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostLogin201JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Path parameter "todo_id" -------------
+	var todoId TodoId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", c.Param("todo_id"), &todoId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter todo_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostLogin400JSONResponse struct{ BadRequestJSONResponse }
+	c.Set(BearerScopes, []string{})
 
-func (response PostLogin400JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PutUsersUserIdTodosTodoId(c, userId, todoId)
 }
 
-type PostLogin500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// DeleteUsersUserIdTodosTodoIdGoodlucks operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersUserIdTodosTodoIdGoodlucks(c *gin.Context) {
 
-func (response PostLogin500JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
 
-type PostLogoutRequestObject struct {
-	Body *PostLogoutJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostLogoutResponseObject interface {
-	VisitPostLogoutResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "todo_id" -------------
+	var todoId TodoId
 
-type PostLogout201JSONResponse LogoutUserResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", c.Param("todo_id"), &todoId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter todo_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostLogout201JSONResponse) VisitPostLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	c.Set(BearerScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteUsersUserIdTodosTodoIdGoodlucks(c, userId, todoId)
 }
 
-type PostLogout400JSONResponse struct{ BadRequestJSONResponse }
+// PostUsersUserIdTodosTodoIdGoodlucks operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUserIdTodosTodoIdGoodlucks(c *gin.Context) {
 
-func (response PostLogout400JSONResponse) VisitPostLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
 
-type PostLogout401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostLogout401JSONResponse) VisitPostLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ------------- Path parameter "todo_id" -------------
+	var todoId TodoId
 
-	return json.NewEncoder(w).Encode(response)
-}
-
-type PostLogout500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
-
-func (response PostLogout500JSONResponse) VisitPostLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", c.Param("todo_id"), &todoId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter todo_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(BearerScopes, []string{})
 
-type PostRegisterRequestObject struct {
-	Body *PostRegisterJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostRegisterResponseObject interface {
-	VisitPostRegisterResponse(w http.ResponseWriter) error
+	siw.Handler.PostUsersUserIdTodosTodoIdGoodlucks(c, userId, todoId)
 }
 
-type PostRegister201JSONResponse RegisterUserResponse
+// GetUsersUserIdTodosTodoIdHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersUserIdTodosTodoIdHistory(c *gin.Context) {
 
-func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
 
-type PostRegister400JSONResponse struct{ BadRequestJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostRegister400JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Path parameter "todo_id" -------------
+	var todoId TodoId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", c.Param("todo_id"), &todoId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter todo_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostRegister500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	c.Set(BearerScopes, []string{})
 
-func (response PostRegister500JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetUsersUserIdTodosTodoIdHistory(c, userId, todoId)
 }
 
-type GetUsersUserIdRequestObject struct {
-	UserId UserId `json:"user_id"`
-}
+// PostUsersUserIdTodosTodoIdHistoryRevRevert operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUserIdTodosTodoIdHistoryRevRevert(c *gin.Context) {
 
-type GetUsersUserIdResponseObject interface {
-	VisitGetUsersUserIdResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetUsersUserId200JSONResponse GetUserDetailResponse
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
 
-func (response GetUsersUserId200JSONResponse) VisitGetUsersUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "todo_id" -------------
+	var todoId TodoId
 
-type GetUsersUserId400JSONResponse struct{ BadRequestJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "todo_id", c.Param("todo_id"), &todoId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter todo_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetUsersUserId400JSONResponse) VisitGetUsersUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Path parameter "rev" -------------
+	var rev Rev
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "rev", c.Param("rev"), &rev, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter rev: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetUsersUserId401JSONResponse struct{ UnauthorizedJSONResponse }
+	c.Set(BearerScopes, []string{})
 
-func (response GetUsersUserId401JSONResponse) VisitGetUsersUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostUsersUserIdTodosTodoIdHistoryRevRevert(c, userId, todoId, rev)
 }
 
-type GetUsersUserId403JSONResponse struct{ ForbiddenJSONResponse }
+// PostUsersUserIdTodosBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUserIdTodosBatch(c *gin.Context) {
 
-func (response GetUsersUserId403JSONResponse) VisitGetUsersUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
 
-type GetUsersUserId404JSONResponse struct{ NotFoundJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetUsersUserId404JSONResponse) VisitGetUsersUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	c.Set(BearerScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetUsersUserId500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	siw.Handler.PostUsersUserIdTodosBatch(c, userId)
 }
 
-func (response GetUsersUserId500JSONResponse) VisitGetUsersUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetUsersUserIdTokens operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersUserIdTokens(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PutUsersUserIdRequestObject struct {
-	UserId UserId `json:"user_id"`
-	Body   *PutUsersUserIdJSONRequestBody
-}
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
 
-type PutUsersUserIdResponseObject interface {
-	VisitPutUsersUserIdResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PutUsersUserId200JSONResponse UpdateUserResponse
+	c.Set(BearerScopes, []string{})
 
-func (response PutUsersUserId200JSONResponse) VisitPutUsersUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetUsersUserIdTokens(c, userId)
 }
 
-type PutUsersUserId400JSONResponse struct{ BadRequestJSONResponse }
+// PostUsersUserIdTokens operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUserIdTokens(c *gin.Context) {
 
-func (response PutUsersUserId400JSONResponse) VisitPutUsersUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
 
-type PutUsersUserId401JSONResponse struct{ UnauthorizedJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PutUsersUserId401JSONResponse) VisitPutUsersUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	c.Set(BearerScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PutUsersUserId403JSONResponse struct{ ForbiddenJSONResponse }
+	siw.Handler.PostUsersUserIdTokens(c, userId)
+}
 
-func (response PutUsersUserId403JSONResponse) VisitPutUsersUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// DeleteUsersUserIdTokensTokenId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersUserIdTokensTokenId(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PutUsersUserId404JSONResponse struct{ NotFoundJSONResponse }
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
 
-func (response PutUsersUserId404JSONResponse) VisitPutUsersUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "token_id" -------------
+	var tokenId TokenId
 
-type PutUsersUserId500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "token_id", c.Param("token_id"), &tokenId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PutUsersUserId500JSONResponse) VisitPutUsersUserIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	c.Set(BearerScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetUsersUserIdTodosRequestObject struct {
-	UserId UserId `json:"user_id"`
+	siw.Handler.DeleteUsersUserIdTokensTokenId(c, userId, tokenId)
 }
 
-type GetUsersUserIdTodosResponseObject interface {
-	VisitGetUsersUserIdTodosResponse(w http.ResponseWriter) error
-}
+// PostUsersUserIdUpgrade operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUserIdUpgrade(c *gin.Context) {
 
-type GetUsersUserIdTodos200JSONResponse GetTodoListResponse
+	var err error
 
-func (response GetUsersUserIdTodos200JSONResponse) VisitGetUsersUserIdTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetUsersUserIdTodos400JSONResponse struct{ BadRequestJSONResponse }
+	c.Set(BearerScopes, []string{})
 
-func (response GetUsersUserIdTodos400JSONResponse) VisitGetUsersUserIdTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostUsersUserIdUpgrade(c, userId)
 }
 
-type GetUsersUserIdTodos401JSONResponse struct{ UnauthorizedJSONResponse }
+// GetUsersUserIdWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersUserIdWorkflow(c *gin.Context) {
 
-func (response GetUsersUserIdTodos401JSONResponse) VisitGetUsersUserIdTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
 
-type GetUsersUserIdTodos403JSONResponse struct{ ForbiddenJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetUsersUserIdTodos403JSONResponse) VisitGetUsersUserIdTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	c.Set(BearerScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersUserIdWorkflow(c, userId)
 }
 
-type GetUsersUserIdTodos404JSONResponse struct{ NotFoundJSONResponse }
+// PutUsersUserIdWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) PutUsersUserIdWorkflow(c *gin.Context) {
 
-func (response GetUsersUserIdTodos404JSONResponse) VisitGetUsersUserIdTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "user_id" -------------
+	var userId UserId
 
-type GetUsersUserIdTodos500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", c.Param("user_id"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetUsersUserIdTodos500JSONResponse) VisitGetUsersUserIdTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	c.Set(BearerScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutUsersUserIdWorkflow(c, userId)
 }
 
-type PostUsersUserIdTodosRequestObject struct {
-	UserId UserId `json:"user_id"`
-	Body   *PostUsersUserIdTodosJSONRequestBody
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
 }
 
-type PostUsersUserIdTodosResponseObject interface {
-	VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
 }
 
-type PostUsersUserIdTodos201JSONResponse CreateTodoResponse
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
 
-func (response PostUsersUserIdTodos201JSONResponse) VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	router.POST(options.BaseURL+"/admin/todo-statuses", wrapper.PostAdminTodoStatuses)
+	router.GET(options.BaseURL+"/admin/users", wrapper.GetAdminUsers)
+	router.POST(options.BaseURL+"/admin/users/:user_id/disable", wrapper.PostAdminUsersUserIdDisable)
+	router.POST(options.BaseURL+"/admin/users/:user_id/enable", wrapper.PostAdminUsersUserIdEnable)
+	router.GET(options.BaseURL+"/admin/users/:user_id/todos", wrapper.GetAdminUsersUserIdTodos)
+	router.POST(options.BaseURL+"/login", wrapper.PostLogin)
+	router.POST(options.BaseURL+"/logout", wrapper.PostLogout)
+	router.POST(options.BaseURL+"/register", wrapper.PostRegister)
+	router.POST(options.BaseURL+"/register/anonymous", wrapper.PostRegisterAnonymous)
+	router.GET(options.BaseURL+"/todo-statuses", wrapper.GetTodoStatuses)
+	router.POST(options.BaseURL+"/token", wrapper.PostToken)
+	router.GET(options.BaseURL+"/users/:user_id", wrapper.GetUsersUserId)
+	router.PATCH(options.BaseURL+"/users/:user_id", wrapper.PatchUsersUserId)
+	router.PUT(options.BaseURL+"/users/:user_id", wrapper.PutUsersUserId)
+	router.GET(options.BaseURL+"/users/:user_id/todos", wrapper.GetUsersUserIdTodos)
+	router.POST(options.BaseURL+"/users/:user_id/todos", wrapper.PostUsersUserIdTodos)
+	router.DELETE(options.BaseURL+"/users/:user_id/todos/:todo_id", wrapper.DeleteUsersUserIdTodosTodoId)
+	router.GET(options.BaseURL+"/users/:user_id/todos/:todo_id", wrapper.GetUsersUserIdTodosTodoId)
+	router.PATCH(options.BaseURL+"/users/:user_id/todos/:todo_id", wrapper.PatchUsersUserIdTodosTodoId)
+	router.PUT(options.BaseURL+"/users/:user_id/todos/:todo_id", wrapper.PutUsersUserIdTodosTodoId)
+	router.DELETE(options.BaseURL+"/users/:user_id/todos/:todo_id/goodlucks", wrapper.DeleteUsersUserIdTodosTodoIdGoodlucks)
+	router.POST(options.BaseURL+"/users/:user_id/todos/:todo_id/goodlucks", wrapper.PostUsersUserIdTodosTodoIdGoodlucks)
+	router.GET(options.BaseURL+"/users/:user_id/todos/:todo_id/history", wrapper.GetUsersUserIdTodosTodoIdHistory)
+	router.POST(options.BaseURL+"/users/:user_id/todos/:todo_id/history/:rev/revert", wrapper.PostUsersUserIdTodosTodoIdHistoryRevRevert)
+	router.POST(options.BaseURL+"/users/:user_id/todos:batch", wrapper.PostUsersUserIdTodosBatch)
+	router.GET(options.BaseURL+"/users/:user_id/tokens", wrapper.GetUsersUserIdTokens)
+	router.POST(options.BaseURL+"/users/:user_id/tokens", wrapper.PostUsersUserIdTokens)
+	router.DELETE(options.BaseURL+"/users/:user_id/tokens/:token_id", wrapper.DeleteUsersUserIdTokensTokenId)
+	router.POST(options.BaseURL+"/users/:user_id/upgrade", wrapper.PostUsersUserIdUpgrade)
+	router.GET(options.BaseURL+"/users/:user_id/workflow", wrapper.GetUsersUserIdWorkflow)
+	router.PUT(options.BaseURL+"/users/:user_id/workflow", wrapper.PutUsersUserIdWorkflow)
 }
 
-type PostUsersUserIdTodos400JSONResponse struct{ BadRequestJSONResponse }
+type BadRequestApplicationProblemPlusJSONResponse Problem
 
-func (response PostUsersUserIdTodos400JSONResponse) VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+type ForbiddenApplicationProblemPlusJSONResponse Problem
 
-	return json.NewEncoder(w).Encode(response)
+type InternalServerErrorApplicationProblemPlusJSONResponse Problem
+
+type NotFoundApplicationProblemPlusJSONResponse Problem
+
+type PatchTestFailedApplicationProblemPlusJSONResponse Problem
+
+type StatusReasonRequiredApplicationProblemPlusJSONResponse StatusTransitionError
+
+type StatusTransitionNotAllowedApplicationProblemPlusJSONResponse StatusTransitionError
+
+type TooManyRequestsResponseHeaders struct {
+	RetryAfter int
+}
+type TooManyRequestsApplicationProblemPlusJSONResponse struct {
+	Body Problem
+
+	Headers TooManyRequestsResponseHeaders
 }
 
-type PostUsersUserIdTodos401JSONResponse struct{ UnauthorizedJSONResponse }
+type UnauthorizedApplicationProblemPlusJSONResponse Problem
 
-func (response PostUsersUserIdTodos401JSONResponse) VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error {
+type UnsupportedMediaTypeApplicationProblemPlusJSONResponse Problem
+
+type PostAdminTodoStatusesRequestObject struct {
+	Body *PostAdminTodoStatusesJSONRequestBody
+}
+
+type PostAdminTodoStatusesResponseObject interface {
+	VisitPostAdminTodoStatusesResponse(w http.ResponseWriter) error
+}
+
+type PostAdminTodoStatuses201JSONResponse TodoStatusInfo
+
+func (response PostAdminTodoStatuses201JSONResponse) VisitPostAdminTodoStatusesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersUserIdTodos403JSONResponse struct{ ForbiddenJSONResponse }
+type PostAdminTodoStatuses400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
 
-func (response PostUsersUserIdTodos403JSONResponse) VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+func (response PostAdminTodoStatuses400ApplicationProblemPlusJSONResponse) VisitPostAdminTodoStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersUserIdTodos404JSONResponse struct{ NotFoundJSONResponse }
+type PostAdminTodoStatuses401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
 
-func (response PostUsersUserIdTodos404JSONResponse) VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+func (response PostAdminTodoStatuses401ApplicationProblemPlusJSONResponse) VisitPostAdminTodoStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersUserIdTodos500JSONResponse struct {
-	InternalServerErrorJSONResponse
+type PostAdminTodoStatuses403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (response PostUsersUserIdTodos500JSONResponse) VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+func (response PostAdminTodoStatuses403ApplicationProblemPlusJSONResponse) VisitPostAdminTodoStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUsersUserIdTodosTodoIdRequestObject struct {
-	UserId UserId `json:"user_id"`
-	TodoId TodoId `json:"todo_id"`
+type PostAdminTodoStatuses500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
 }
 
-type DeleteUsersUserIdTodosTodoIdResponseObject interface {
-	VisitDeleteUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error
+func (response PostAdminTodoStatuses500ApplicationProblemPlusJSONResponse) VisitPostAdminTodoStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUsersUserIdTodosTodoId204Response struct {
+type GetAdminUsersRequestObject struct {
+	Params GetAdminUsersParams
 }
 
-func (response DeleteUsersUserIdTodosTodoId204Response) VisitDeleteUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type GetAdminUsersResponseObject interface {
+	VisitGetAdminUsersResponse(w http.ResponseWriter) error
 }
 
-type DeleteUsersUserIdTodosTodoId400JSONResponse struct{ BadRequestJSONResponse }
+type GetAdminUsers200JSONResponse AdminUserListResponse
 
-func (response DeleteUsersUserIdTodosTodoId400JSONResponse) VisitDeleteUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+func (response GetAdminUsers200JSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUsersUserIdTodosTodoId401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetAdminUsers400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
 
-func (response DeleteUsersUserIdTodosTodoId401JSONResponse) VisitDeleteUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+func (response GetAdminUsers400ApplicationProblemPlusJSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUsersUserIdTodosTodoId403JSONResponse struct{ ForbiddenJSONResponse }
+type GetAdminUsers401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
 
-func (response DeleteUsersUserIdTodosTodoId403JSONResponse) VisitDeleteUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+func (response GetAdminUsers401ApplicationProblemPlusJSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUsersUserIdTodosTodoId404JSONResponse struct{ NotFoundJSONResponse }
+type GetAdminUsers403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
 
-func (response DeleteUsersUserIdTodosTodoId404JSONResponse) VisitDeleteUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+func (response GetAdminUsers403ApplicationProblemPlusJSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUsersUserIdTodosTodoId500JSONResponse struct {
-	InternalServerErrorJSONResponse
+type GetAdminUsers500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
 }
 
-func (response DeleteUsersUserIdTodosTodoId500JSONResponse) VisitDeleteUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetAdminUsers500ApplicationProblemPlusJSONResponse) VisitGetAdminUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersUserIdTodosTodoIdRequestObject struct {
+type PostAdminUsersUserIdDisableRequestObject struct {
 	UserId UserId `json:"user_id"`
-	TodoId TodoId `json:"todo_id"`
 }
 
-type GetUsersUserIdTodosTodoIdResponseObject interface {
-	VisitGetUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error
+type PostAdminUsersUserIdDisableResponseObject interface {
+	VisitPostAdminUsersUserIdDisableResponse(w http.ResponseWriter) error
 }
 
-type GetUsersUserIdTodosTodoId200JSONResponse GetTodoDetailResponse
+type PostAdminUsersUserIdDisable200JSONResponse AdminUser
 
-func (response GetUsersUserIdTodosTodoId200JSONResponse) VisitGetUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+func (response PostAdminUsersUserIdDisable200JSONResponse) VisitPostAdminUsersUserIdDisableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersUserIdTodosTodoId400JSONResponse struct{ BadRequestJSONResponse }
+type PostAdminUsersUserIdDisable400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
 
-func (response GetUsersUserIdTodosTodoId400JSONResponse) VisitGetUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PostAdminUsersUserIdDisable400ApplicationProblemPlusJSONResponse) VisitPostAdminUsersUserIdDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersUserIdTodosTodoId401JSONResponse struct{ UnauthorizedJSONResponse }
+type PostAdminUsersUserIdDisable401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
 
-func (response GetUsersUserIdTodosTodoId401JSONResponse) VisitGetUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PostAdminUsersUserIdDisable401ApplicationProblemPlusJSONResponse) VisitPostAdminUsersUserIdDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersUserIdTodosTodoId403JSONResponse struct{ ForbiddenJSONResponse }
+type PostAdminUsersUserIdDisable403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
 
-func (response GetUsersUserIdTodosTodoId403JSONResponse) VisitGetUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PostAdminUsersUserIdDisable403ApplicationProblemPlusJSONResponse) VisitPostAdminUsersUserIdDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersUserIdTodosTodoId404JSONResponse struct{ NotFoundJSONResponse }
+type PostAdminUsersUserIdDisable404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
 
-func (response GetUsersUserIdTodosTodoId404JSONResponse) VisitGetUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PostAdminUsersUserIdDisable404ApplicationProblemPlusJSONResponse) VisitPostAdminUsersUserIdDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersUserIdTodosTodoId500JSONResponse struct {
-	InternalServerErrorJSONResponse
+type PostAdminUsersUserIdDisable500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
 }
 
-func (response GetUsersUserIdTodosTodoId500JSONResponse) VisitGetUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PostAdminUsersUserIdDisable500ApplicationProblemPlusJSONResponse) VisitPostAdminUsersUserIdDisableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutUsersUserIdTodosTodoIdRequestObject struct {
+type PostAdminUsersUserIdEnableRequestObject struct {
 	UserId UserId `json:"user_id"`
-	TodoId TodoId `json:"todo_id"`
-	Body   *PutUsersUserIdTodosTodoIdJSONRequestBody
 }
 
-type PutUsersUserIdTodosTodoIdResponseObject interface {
-	VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error
+type PostAdminUsersUserIdEnableResponseObject interface {
+	VisitPostAdminUsersUserIdEnableResponse(w http.ResponseWriter) error
 }
 
-type PutUsersUserIdTodosTodoId200JSONResponse UpdateTodoResponse
+type PostAdminUsersUserIdEnable200JSONResponse AdminUser
 
-func (response PutUsersUserIdTodosTodoId200JSONResponse) VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+func (response PostAdminUsersUserIdEnable200JSONResponse) VisitPostAdminUsersUserIdEnableResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutUsersUserIdTodosTodoId400JSONResponse struct{ BadRequestJSONResponse }
+type PostAdminUsersUserIdEnable400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
 
-func (response PutUsersUserIdTodosTodoId400JSONResponse) VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PostAdminUsersUserIdEnable400ApplicationProblemPlusJSONResponse) VisitPostAdminUsersUserIdEnableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutUsersUserIdTodosTodoId401JSONResponse struct{ UnauthorizedJSONResponse }
+type PostAdminUsersUserIdEnable401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
 
-func (response PutUsersUserIdTodosTodoId401JSONResponse) VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PostAdminUsersUserIdEnable401ApplicationProblemPlusJSONResponse) VisitPostAdminUsersUserIdEnableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutUsersUserIdTodosTodoId403JSONResponse struct{ ForbiddenJSONResponse }
+type PostAdminUsersUserIdEnable403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
 
-func (response PutUsersUserIdTodosTodoId403JSONResponse) VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PostAdminUsersUserIdEnable403ApplicationProblemPlusJSONResponse) VisitPostAdminUsersUserIdEnableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutUsersUserIdTodosTodoId404JSONResponse struct{ NotFoundJSONResponse }
+type PostAdminUsersUserIdEnable404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
 
-func (response PutUsersUserIdTodosTodoId404JSONResponse) VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PostAdminUsersUserIdEnable404ApplicationProblemPlusJSONResponse) VisitPostAdminUsersUserIdEnableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PutUsersUserIdTodosTodoId500JSONResponse struct {
-	InternalServerErrorJSONResponse
+type PostAdminUsersUserIdEnable500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
 }
 
-func (response PutUsersUserIdTodosTodoId500JSONResponse) VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PostAdminUsersUserIdEnable500ApplicationProblemPlusJSONResponse) VisitPostAdminUsersUserIdEnableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUsersUserIdTodosTodoIdGoodlucksRequestObject struct {
+type GetAdminUsersUserIdTodosRequestObject struct {
 	UserId UserId `json:"user_id"`
-	TodoId TodoId `json:"todo_id"`
 }
 
-type DeleteUsersUserIdTodosTodoIdGoodlucksResponseObject interface {
-	VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error
+type GetAdminUsersUserIdTodosResponseObject interface {
+	VisitGetAdminUsersUserIdTodosResponse(w http.ResponseWriter) error
 }
 
-type DeleteUsersUserIdTodosTodoIdGoodlucks204Response struct {
-}
+type GetAdminUsersUserIdTodos200JSONResponse GetTodoListResponse
 
-func (response DeleteUsersUserIdTodosTodoIdGoodlucks204Response) VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response GetAdminUsersUserIdTodos200JSONResponse) VisitGetAdminUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUsersUserIdTodosTodoIdGoodlucks400JSONResponse struct{ BadRequestJSONResponse }
+type GetAdminUsersUserIdTodos400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
 
-func (response DeleteUsersUserIdTodosTodoIdGoodlucks400JSONResponse) VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetAdminUsersUserIdTodos400ApplicationProblemPlusJSONResponse) VisitGetAdminUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUsersUserIdTodosTodoIdGoodlucks401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetAdminUsersUserIdTodos401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
 
-func (response DeleteUsersUserIdTodosTodoIdGoodlucks401JSONResponse) VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetAdminUsersUserIdTodos401ApplicationProblemPlusJSONResponse) VisitGetAdminUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUsersUserIdTodosTodoIdGoodlucks403JSONResponse struct{ ForbiddenJSONResponse }
+type GetAdminUsersUserIdTodos403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
 
-func (response DeleteUsersUserIdTodosTodoIdGoodlucks403JSONResponse) VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetAdminUsersUserIdTodos403ApplicationProblemPlusJSONResponse) VisitGetAdminUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUsersUserIdTodosTodoIdGoodlucks404JSONResponse struct{ NotFoundJSONResponse }
+type GetAdminUsersUserIdTodos404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
 
-func (response DeleteUsersUserIdTodosTodoIdGoodlucks404JSONResponse) VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetAdminUsersUserIdTodos404ApplicationProblemPlusJSONResponse) VisitGetAdminUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUsersUserIdTodosTodoIdGoodlucks500JSONResponse struct {
-	InternalServerErrorJSONResponse
+type GetAdminUsersUserIdTodos500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
 }
 
-func (response DeleteUsersUserIdTodosTodoIdGoodlucks500JSONResponse) VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetAdminUsersUserIdTodos500ApplicationProblemPlusJSONResponse) VisitGetAdminUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersUserIdTodosTodoIdGoodlucksRequestObject struct {
-	UserId UserId `json:"user_id"`
-	TodoId TodoId `json:"todo_id"`
-	Body   *PostUsersUserIdTodosTodoIdGoodlucksJSONRequestBody
+type PostLoginRequestObject struct {
+	Body *PostLoginJSONRequestBody
 }
 
-type PostUsersUserIdTodosTodoIdGoodlucksResponseObject interface {
-	VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error
+type PostLoginResponseObject interface {
+	VisitPostLoginResponse(w http.ResponseWriter) error
 }
 
-type PostUsersUserIdTodosTodoIdGoodlucks201JSONResponse CreateGoodluckResponse
+type PostLogin201JSONResponse LoginUserResponse
 
-func (response PostUsersUserIdTodosTodoIdGoodlucks201JSONResponse) VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
+func (response PostLogin201JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersUserIdTodosTodoIdGoodlucks400JSONResponse struct{ BadRequestJSONResponse }
+type PostLogin400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
 
-func (response PostUsersUserIdTodosTodoIdGoodlucks400JSONResponse) VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PostLogin400ApplicationProblemPlusJSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersUserIdTodosTodoIdGoodlucks401JSONResponse struct{ UnauthorizedJSONResponse }
+type PostLogin429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostLogin429ApplicationProblemPlusJSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostLogin500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostLogin500ApplicationProblemPlusJSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogoutRequestObject struct {
+	Body *PostLogoutJSONRequestBody
+}
+
+type PostLogoutResponseObject interface {
+	VisitPostLogoutResponse(w http.ResponseWriter) error
+}
+
+type PostLogout201JSONResponse LogoutUserResponse
 
-func (response PostUsersUserIdTodosTodoIdGoodlucks401JSONResponse) VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
+func (response PostLogout201JSONResponse) VisitPostLogoutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogout400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostLogout400ApplicationProblemPlusJSONResponse) VisitPostLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogout401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostLogout401ApplicationProblemPlusJSONResponse) VisitPostLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersUserIdTodosTodoIdGoodlucks403JSONResponse struct{ ForbiddenJSONResponse }
+type PostLogout403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
 
-func (response PostUsersUserIdTodosTodoIdGoodlucks403JSONResponse) VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response PostLogout403ApplicationProblemPlusJSONResponse) VisitPostLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(403)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogout500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostLogout500ApplicationProblemPlusJSONResponse) VisitPostLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegisterRequestObject struct {
+	Body *PostRegisterJSONRequestBody
+}
+
+type PostRegisterResponseObject interface {
+	VisitPostRegisterResponse(w http.ResponseWriter) error
+}
+
+type PostRegister201JSONResponse RegisterUserResponse
+
+func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegister400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostRegister400ApplicationProblemPlusJSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegister429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostRegister429ApplicationProblemPlusJSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostRegister500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostRegister500ApplicationProblemPlusJSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegisterAnonymousRequestObject struct {
+}
+
+type PostRegisterAnonymousResponseObject interface {
+	VisitPostRegisterAnonymousResponse(w http.ResponseWriter) error
+}
+
+type PostRegisterAnonymous201JSONResponse RegisterAnonymousUserResponse
+
+func (response PostRegisterAnonymous201JSONResponse) VisitPostRegisterAnonymousResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegisterAnonymous400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostRegisterAnonymous400ApplicationProblemPlusJSONResponse) VisitPostRegisterAnonymousResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegisterAnonymous429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostRegisterAnonymous429ApplicationProblemPlusJSONResponse) VisitPostRegisterAnonymousResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostRegisterAnonymous500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostRegisterAnonymous500ApplicationProblemPlusJSONResponse) VisitPostRegisterAnonymousResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoStatusesRequestObject struct {
+}
+
+type GetTodoStatusesResponseObject interface {
+	VisitGetTodoStatusesResponse(w http.ResponseWriter) error
+}
+
+type GetTodoStatuses200JSONResponse TodoStatusListResponse
+
+func (response GetTodoStatuses200JSONResponse) VisitGetTodoStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTodoStatuses500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetTodoStatuses500ApplicationProblemPlusJSONResponse) VisitGetTodoStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTokenRequestObject struct {
+	Body *PostTokenJSONRequestBody
+}
+
+type PostTokenResponseObject interface {
+	VisitPostTokenResponse(w http.ResponseWriter) error
+}
+
+type PostToken200JSONResponse RefreshTokenResponse
+
+func (response PostToken200JSONResponse) VisitPostTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostToken400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostToken400ApplicationProblemPlusJSONResponse) VisitPostTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostToken401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostToken401ApplicationProblemPlusJSONResponse) VisitPostTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostToken429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostToken429ApplicationProblemPlusJSONResponse) VisitPostTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostToken500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostToken500ApplicationProblemPlusJSONResponse) VisitPostTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdRequestObject struct {
+	UserId UserId `json:"user_id"`
+}
+
+type GetUsersUserIdResponseObject interface {
+	VisitGetUsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type GetUsersUserId200JSONResponse GetUserDetailResponse
+
+func (response GetUsersUserId200JSONResponse) VisitGetUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserId400ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserId401ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserId403ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserId404ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserId500ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserIdRequestObject struct {
+	UserId                            UserId `json:"user_id"`
+	ApplicationJSONPatchPlusJSONBody  *PatchUsersUserIdApplicationJSONPatchPlusJSONRequestBody
+	ApplicationMergePatchPlusJSONBody *PatchUsersUserIdApplicationMergePatchPlusJSONRequestBody
+}
+
+type PatchUsersUserIdResponseObject interface {
+	VisitPatchUsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type PatchUsersUserId200JSONResponse UpdateUserResponse
+
+func (response PatchUsersUserId200JSONResponse) VisitPatchUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PatchUsersUserId400ApplicationProblemPlusJSONResponse) VisitPatchUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PatchUsersUserId401ApplicationProblemPlusJSONResponse) VisitPatchUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PatchUsersUserId403ApplicationProblemPlusJSONResponse) VisitPatchUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PatchUsersUserId404ApplicationProblemPlusJSONResponse) VisitPatchUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserId409ApplicationProblemPlusJSONResponse struct {
+	PatchTestFailedApplicationProblemPlusJSONResponse
+}
+
+func (response PatchUsersUserId409ApplicationProblemPlusJSONResponse) VisitPatchUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserId415ApplicationProblemPlusJSONResponse struct {
+	UnsupportedMediaTypeApplicationProblemPlusJSONResponse
+}
+
+func (response PatchUsersUserId415ApplicationProblemPlusJSONResponse) VisitPatchUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(415)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PatchUsersUserId500ApplicationProblemPlusJSONResponse) VisitPatchUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdRequestObject struct {
+	UserId UserId `json:"user_id"`
+	Body   *PutUsersUserIdJSONRequestBody
+}
+
+type PutUsersUserIdResponseObject interface {
+	VisitPutUsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type PutUsersUserId200JSONResponse UpdateUserResponse
+
+func (response PutUsersUserId200JSONResponse) VisitPutUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserId400ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserId401ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserId403ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserId404ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserId500ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodosRequestObject struct {
+	UserId UserId `json:"user_id"`
+}
+
+type GetUsersUserIdTodosResponseObject interface {
+	VisitGetUsersUserIdTodosResponse(w http.ResponseWriter) error
+}
+
+type GetUsersUserIdTodos200JSONResponse GetTodoListResponse
+
+func (response GetUsersUserIdTodos200JSONResponse) VisitGetUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodos400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTodos400ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodos401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTodos401ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodos403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTodos403ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodos404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTodos404ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodos500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTodos500ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosRequestObject struct {
+	UserId UserId `json:"user_id"`
+	Body   *PostUsersUserIdTodosJSONRequestBody
+}
+
+type PostUsersUserIdTodosResponseObject interface {
+	VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdTodos201JSONResponse CreateTodoResponse
+
+func (response PostUsersUserIdTodos201JSONResponse) VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodos400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodos400ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodos401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodos401ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodos403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodos403ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodos404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodos404ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodos409ApplicationProblemPlusJSONResponse struct {
+	StatusTransitionNotAllowedApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodos409ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodos422ApplicationProblemPlusJSONResponse struct {
+	StatusReasonRequiredApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodos422ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodos500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodos500ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdTodosTodoIdRequestObject struct {
+	UserId UserId `json:"user_id"`
+	TodoId TodoId `json:"todo_id"`
+}
+
+type DeleteUsersUserIdTodosTodoIdResponseObject interface {
+	VisitDeleteUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteUsersUserIdTodosTodoId204Response struct {
+}
+
+func (response DeleteUsersUserIdTodosTodoId204Response) VisitDeleteUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteUsersUserIdTodosTodoId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersUserIdTodosTodoId400ApplicationProblemPlusJSONResponse) VisitDeleteUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdTodosTodoId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersUserIdTodosTodoId401ApplicationProblemPlusJSONResponse) VisitDeleteUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdTodosTodoId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersUserIdTodosTodoId403ApplicationProblemPlusJSONResponse) VisitDeleteUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdTodosTodoId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersUserIdTodosTodoId404ApplicationProblemPlusJSONResponse) VisitDeleteUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdTodosTodoId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersUserIdTodosTodoId500ApplicationProblemPlusJSONResponse) VisitDeleteUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodosTodoIdRequestObject struct {
+	UserId UserId `json:"user_id"`
+	TodoId TodoId `json:"todo_id"`
+}
+
+type GetUsersUserIdTodosTodoIdResponseObject interface {
+	VisitGetUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error
+}
+
+type GetUsersUserIdTodosTodoId200JSONResponse GetTodoDetailResponse
+
+func (response GetUsersUserIdTodosTodoId200JSONResponse) VisitGetUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodosTodoId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTodosTodoId400ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodosTodoId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTodosTodoId401ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodosTodoId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTodosTodoId403ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodosTodoId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTodosTodoId404ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodosTodoId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTodosTodoId500ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserIdTodosTodoIdRequestObject struct {
+	UserId                            UserId `json:"user_id"`
+	TodoId                            TodoId `json:"todo_id"`
+	ApplicationJSONPatchPlusJSONBody  *PatchUsersUserIdTodosTodoIdApplicationJSONPatchPlusJSONRequestBody
+	ApplicationMergePatchPlusJSONBody *PatchUsersUserIdTodosTodoIdApplicationMergePatchPlusJSONRequestBody
+}
+
+type PatchUsersUserIdTodosTodoIdResponseObject interface {
+	VisitPatchUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error
+}
+
+type PatchUsersUserIdTodosTodoId200JSONResponse GetTodoDetailResponse
+
+func (response PatchUsersUserIdTodosTodoId200JSONResponse) VisitPatchUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserIdTodosTodoId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PatchUsersUserIdTodosTodoId400ApplicationProblemPlusJSONResponse) VisitPatchUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserIdTodosTodoId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PatchUsersUserIdTodosTodoId401ApplicationProblemPlusJSONResponse) VisitPatchUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserIdTodosTodoId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PatchUsersUserIdTodosTodoId403ApplicationProblemPlusJSONResponse) VisitPatchUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserIdTodosTodoId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PatchUsersUserIdTodosTodoId404ApplicationProblemPlusJSONResponse) VisitPatchUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserIdTodosTodoId409ApplicationProblemPlusJSONResponse StatusTransitionError
+
+func (response PatchUsersUserIdTodosTodoId409ApplicationProblemPlusJSONResponse) VisitPatchUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserIdTodosTodoId415ApplicationProblemPlusJSONResponse struct {
+	UnsupportedMediaTypeApplicationProblemPlusJSONResponse
+}
+
+func (response PatchUsersUserIdTodosTodoId415ApplicationProblemPlusJSONResponse) VisitPatchUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(415)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserIdTodosTodoId422ApplicationProblemPlusJSONResponse struct {
+	StatusReasonRequiredApplicationProblemPlusJSONResponse
+}
+
+func (response PatchUsersUserIdTodosTodoId422ApplicationProblemPlusJSONResponse) VisitPatchUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserIdTodosTodoId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PatchUsersUserIdTodosTodoId500ApplicationProblemPlusJSONResponse) VisitPatchUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdTodosTodoIdRequestObject struct {
+	UserId UserId `json:"user_id"`
+	TodoId TodoId `json:"todo_id"`
+	Body   *PutUsersUserIdTodosTodoIdJSONRequestBody
+}
+
+type PutUsersUserIdTodosTodoIdResponseObject interface {
+	VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error
+}
+
+type PutUsersUserIdTodosTodoId200JSONResponse UpdateTodoResponse
+
+func (response PutUsersUserIdTodosTodoId200JSONResponse) VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdTodosTodoId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserIdTodosTodoId400ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdTodosTodoId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserIdTodosTodoId401ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdTodosTodoId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserIdTodosTodoId403ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdTodosTodoId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserIdTodosTodoId404ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdTodosTodoId409ApplicationProblemPlusJSONResponse struct {
+	StatusTransitionNotAllowedApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserIdTodosTodoId409ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdTodosTodoId422ApplicationProblemPlusJSONResponse struct {
+	StatusReasonRequiredApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserIdTodosTodoId422ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdTodosTodoId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserIdTodosTodoId500ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdTodosTodoIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdTodosTodoIdGoodlucksRequestObject struct {
+	UserId UserId `json:"user_id"`
+	TodoId TodoId `json:"todo_id"`
+}
+
+type DeleteUsersUserIdTodosTodoIdGoodlucksResponseObject interface {
+	VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error
+}
+
+type DeleteUsersUserIdTodosTodoIdGoodlucks204Response struct {
+}
+
+func (response DeleteUsersUserIdTodosTodoIdGoodlucks204Response) VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteUsersUserIdTodosTodoIdGoodlucks400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersUserIdTodosTodoIdGoodlucks400ApplicationProblemPlusJSONResponse) VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdTodosTodoIdGoodlucks401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersUserIdTodosTodoIdGoodlucks401ApplicationProblemPlusJSONResponse) VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdTodosTodoIdGoodlucks403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersUserIdTodosTodoIdGoodlucks403ApplicationProblemPlusJSONResponse) VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdTodosTodoIdGoodlucks404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersUserIdTodosTodoIdGoodlucks404ApplicationProblemPlusJSONResponse) VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdTodosTodoIdGoodlucks500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersUserIdTodosTodoIdGoodlucks500ApplicationProblemPlusJSONResponse) VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosTodoIdGoodlucksRequestObject struct {
+	UserId UserId `json:"user_id"`
+	TodoId TodoId `json:"todo_id"`
+	Body   *PostUsersUserIdTodosTodoIdGoodlucksJSONRequestBody
+}
+
+type PostUsersUserIdTodosTodoIdGoodlucksResponseObject interface {
+	VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdTodosTodoIdGoodlucks201JSONResponse CreateGoodluckResponse
+
+func (response PostUsersUserIdTodosTodoIdGoodlucks201JSONResponse) VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosTodoIdGoodlucks400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosTodoIdGoodlucks400ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosTodoIdGoodlucks401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosTodoIdGoodlucks401ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosTodoIdGoodlucks403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosTodoIdGoodlucks403ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosTodoIdGoodlucks404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosTodoIdGoodlucks404ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosTodoIdGoodlucks500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosTodoIdGoodlucks500ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodosTodoIdHistoryRequestObject struct {
+	UserId UserId `json:"user_id"`
+	TodoId TodoId `json:"todo_id"`
+}
+
+type GetUsersUserIdTodosTodoIdHistoryResponseObject interface {
+	VisitGetUsersUserIdTodosTodoIdHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetUsersUserIdTodosTodoIdHistory200JSONResponse TodoHistoryResponse
+
+func (response GetUsersUserIdTodosTodoIdHistory200JSONResponse) VisitGetUsersUserIdTodosTodoIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodosTodoIdHistory401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTodosTodoIdHistory401ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTodosTodoIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodosTodoIdHistory403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTodosTodoIdHistory403ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTodosTodoIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodosTodoIdHistory404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTodosTodoIdHistory404ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTodosTodoIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTodosTodoIdHistory500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTodosTodoIdHistory500ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTodosTodoIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosTodoIdHistoryRevRevertRequestObject struct {
+	UserId UserId `json:"user_id"`
+	TodoId TodoId `json:"todo_id"`
+	Rev    Rev    `json:"rev"`
+	Body   *PostUsersUserIdTodosTodoIdHistoryRevRevertJSONRequestBody
+}
+
+type PostUsersUserIdTodosTodoIdHistoryRevRevertResponseObject interface {
+	VisitPostUsersUserIdTodosTodoIdHistoryRevRevertResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdTodosTodoIdHistoryRevRevert200JSONResponse GetTodoDetailResponse
+
+func (response PostUsersUserIdTodosTodoIdHistoryRevRevert200JSONResponse) VisitPostUsersUserIdTodosTodoIdHistoryRevRevertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosTodoIdHistoryRevRevert400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosTodoIdHistoryRevRevert400ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosTodoIdHistoryRevRevertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosTodoIdHistoryRevRevert401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosTodoIdHistoryRevRevert401ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosTodoIdHistoryRevRevertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosTodoIdHistoryRevRevert403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosTodoIdHistoryRevRevert403ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosTodoIdHistoryRevRevertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosTodoIdHistoryRevRevert404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosTodoIdHistoryRevRevert404ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosTodoIdHistoryRevRevertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosTodoIdHistoryRevRevert409ApplicationProblemPlusJSONResponse struct {
+	StatusTransitionNotAllowedApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosTodoIdHistoryRevRevert409ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosTodoIdHistoryRevRevertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosTodoIdHistoryRevRevert422ApplicationProblemPlusJSONResponse struct {
+	StatusReasonRequiredApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosTodoIdHistoryRevRevert422ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosTodoIdHistoryRevRevertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosTodoIdHistoryRevRevert500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosTodoIdHistoryRevRevert500ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosTodoIdHistoryRevRevertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosBatchRequestObject struct {
+	UserId UserId `json:"user_id"`
+	Body   *PostUsersUserIdTodosBatchJSONRequestBody
+}
+
+type PostUsersUserIdTodosBatchResponseObject interface {
+	VisitPostUsersUserIdTodosBatchResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdTodosBatch200JSONResponse BatchTodoResponse
+
+func (response PostUsersUserIdTodosBatch200JSONResponse) VisitPostUsersUserIdTodosBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosBatch400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosBatch400ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosBatch401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosBatch401ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosBatch403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosBatch403ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTodosBatch500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTodosBatch500ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTodosBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTokensRequestObject struct {
+	UserId UserId `json:"user_id"`
+}
+
+type GetUsersUserIdTokensResponseObject interface {
+	VisitGetUsersUserIdTokensResponse(w http.ResponseWriter) error
+}
+
+type GetUsersUserIdTokens200JSONResponse AccessTokenListResponse
+
+func (response GetUsersUserIdTokens200JSONResponse) VisitGetUsersUserIdTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTokens400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTokens400ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTokens401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTokens401ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTokens403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTokens403ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdTokens500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdTokens500ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTokensRequestObject struct {
+	UserId UserId `json:"user_id"`
+	Body   *PostUsersUserIdTokensJSONRequestBody
+}
+
+type PostUsersUserIdTokensResponseObject interface {
+	VisitPostUsersUserIdTokensResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdTokens201JSONResponse CreateAccessTokenResponse
+
+func (response PostUsersUserIdTokens201JSONResponse) VisitPostUsersUserIdTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTokens400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTokens400ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTokens401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTokens401ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTokens403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTokens403ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdTokens429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTokens429ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersUserIdTokens500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdTokens500ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdTokensTokenIdRequestObject struct {
+	UserId  UserId  `json:"user_id"`
+	TokenId TokenId `json:"token_id"`
+}
+
+type DeleteUsersUserIdTokensTokenIdResponseObject interface {
+	VisitDeleteUsersUserIdTokensTokenIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteUsersUserIdTokensTokenId204Response struct {
+}
+
+func (response DeleteUsersUserIdTokensTokenId204Response) VisitDeleteUsersUserIdTokensTokenIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteUsersUserIdTokensTokenId400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersUserIdTokensTokenId400ApplicationProblemPlusJSONResponse) VisitDeleteUsersUserIdTokensTokenIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdTokensTokenId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersUserIdTokensTokenId401ApplicationProblemPlusJSONResponse) VisitDeleteUsersUserIdTokensTokenIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdTokensTokenId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersUserIdTokensTokenId403ApplicationProblemPlusJSONResponse) VisitDeleteUsersUserIdTokensTokenIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdTokensTokenId404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersUserIdTokensTokenId404ApplicationProblemPlusJSONResponse) VisitDeleteUsersUserIdTokensTokenIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdTokensTokenId500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteUsersUserIdTokensTokenId500ApplicationProblemPlusJSONResponse) VisitDeleteUsersUserIdTokensTokenIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdUpgradeRequestObject struct {
+	UserId UserId `json:"user_id"`
+	Body   *PostUsersUserIdUpgradeJSONRequestBody
+}
+
+type PostUsersUserIdUpgradeResponseObject interface {
+	VisitPostUsersUserIdUpgradeResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdUpgrade200JSONResponse UpgradeUserResponse
+
+func (response PostUsersUserIdUpgrade200JSONResponse) VisitPostUsersUserIdUpgradeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdUpgrade400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdUpgrade400ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdUpgradeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdUpgrade401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdUpgrade401ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdUpgradeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdUpgrade403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdUpgrade403ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdUpgradeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdUpgrade404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdUpgrade404ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdUpgradeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdUpgrade500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostUsersUserIdUpgrade500ApplicationProblemPlusJSONResponse) VisitPostUsersUserIdUpgradeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdWorkflowRequestObject struct {
+	UserId UserId `json:"user_id"`
+}
+
+type GetUsersUserIdWorkflowResponseObject interface {
+	VisitGetUsersUserIdWorkflowResponse(w http.ResponseWriter) error
+}
+
+type GetUsersUserIdWorkflow200JSONResponse TodoWorkflow
+
+func (response GetUsersUserIdWorkflow200JSONResponse) VisitGetUsersUserIdWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdWorkflow401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdWorkflow401ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdWorkflow403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdWorkflow403ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdWorkflow500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetUsersUserIdWorkflow500ApplicationProblemPlusJSONResponse) VisitGetUsersUserIdWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdWorkflowRequestObject struct {
+	UserId UserId `json:"user_id"`
+	Body   *PutUsersUserIdWorkflowJSONRequestBody
+}
+
+type PutUsersUserIdWorkflowResponseObject interface {
+	VisitPutUsersUserIdWorkflowResponse(w http.ResponseWriter) error
+}
+
+type PutUsersUserIdWorkflow200JSONResponse TodoWorkflow
+
+func (response PutUsersUserIdWorkflow200JSONResponse) VisitPutUsersUserIdWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdWorkflow400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserIdWorkflow400ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdWorkflow401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserIdWorkflow401ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdWorkflow403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserIdWorkflow403ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdWorkflow500ApplicationProblemPlusJSONResponse struct {
+	InternalServerErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PutUsersUserIdWorkflow500ApplicationProblemPlusJSONResponse) VisitPutUsersUserIdWorkflowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Todoステータス追加（管理者）
+	// (POST /admin/todo-statuses)
+	PostAdminTodoStatuses(ctx context.Context, request PostAdminTodoStatusesRequestObject) (PostAdminTodoStatusesResponseObject, error)
+	// ユーザー一覧取得（管理者）
+	// (GET /admin/users)
+	GetAdminUsers(ctx context.Context, request GetAdminUsersRequestObject) (GetAdminUsersResponseObject, error)
+	// ユーザー無効化（管理者）
+	// (POST /admin/users/{user_id}/disable)
+	PostAdminUsersUserIdDisable(ctx context.Context, request PostAdminUsersUserIdDisableRequestObject) (PostAdminUsersUserIdDisableResponseObject, error)
+	// ユーザー有効化（管理者）
+	// (POST /admin/users/{user_id}/enable)
+	PostAdminUsersUserIdEnable(ctx context.Context, request PostAdminUsersUserIdEnableRequestObject) (PostAdminUsersUserIdEnableResponseObject, error)
+	// ユーザーのTodo一覧取得（管理者）
+	// (GET /admin/users/{user_id}/todos)
+	GetAdminUsersUserIdTodos(ctx context.Context, request GetAdminUsersUserIdTodosRequestObject) (GetAdminUsersUserIdTodosResponseObject, error)
+	// ログイン
+	// (POST /login)
+	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
+	// ログアウト
+	// (POST /logout)
+	PostLogout(ctx context.Context, request PostLogoutRequestObject) (PostLogoutResponseObject, error)
+	// ユーザー登録
+	// (POST /register)
+	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
+	// 匿名ユーザー登録
+	// (POST /register/anonymous)
+	PostRegisterAnonymous(ctx context.Context, request PostRegisterAnonymousRequestObject) (PostRegisterAnonymousResponseObject, error)
+	// Todoステータス一覧取得
+	// (GET /todo-statuses)
+	GetTodoStatuses(ctx context.Context, request GetTodoStatusesRequestObject) (GetTodoStatusesResponseObject, error)
+	// アクセストークン再発行
+	// (POST /token)
+	PostToken(ctx context.Context, request PostTokenRequestObject) (PostTokenResponseObject, error)
+	// ユーザー詳細取得
+	// (GET /users/{user_id})
+	GetUsersUserId(ctx context.Context, request GetUsersUserIdRequestObject) (GetUsersUserIdResponseObject, error)
+	// ユーザー情報部分更新
+	// (PATCH /users/{user_id})
+	PatchUsersUserId(ctx context.Context, request PatchUsersUserIdRequestObject) (PatchUsersUserIdResponseObject, error)
+	// ユーザー情報編集
+	// (PUT /users/{user_id})
+	PutUsersUserId(ctx context.Context, request PutUsersUserIdRequestObject) (PutUsersUserIdResponseObject, error)
+	// Todo一覧取得
+	// (GET /users/{user_id}/todos)
+	GetUsersUserIdTodos(ctx context.Context, request GetUsersUserIdTodosRequestObject) (GetUsersUserIdTodosResponseObject, error)
+	// Todo作成
+	// (POST /users/{user_id}/todos)
+	PostUsersUserIdTodos(ctx context.Context, request PostUsersUserIdTodosRequestObject) (PostUsersUserIdTodosResponseObject, error)
+	// Todo削除
+	// (DELETE /users/{user_id}/todos/{todo_id})
+	DeleteUsersUserIdTodosTodoId(ctx context.Context, request DeleteUsersUserIdTodosTodoIdRequestObject) (DeleteUsersUserIdTodosTodoIdResponseObject, error)
+	// Todo詳細取得
+	// (GET /users/{user_id}/todos/{todo_id})
+	GetUsersUserIdTodosTodoId(ctx context.Context, request GetUsersUserIdTodosTodoIdRequestObject) (GetUsersUserIdTodosTodoIdResponseObject, error)
+	// Todo部分更新
+	// (PATCH /users/{user_id}/todos/{todo_id})
+	PatchUsersUserIdTodosTodoId(ctx context.Context, request PatchUsersUserIdTodosTodoIdRequestObject) (PatchUsersUserIdTodosTodoIdResponseObject, error)
+	// Todo編集
+	// (PUT /users/{user_id}/todos/{todo_id})
+	PutUsersUserIdTodosTodoId(ctx context.Context, request PutUsersUserIdTodosTodoIdRequestObject) (PutUsersUserIdTodosTodoIdResponseObject, error)
+	// いいね削除
+	// (DELETE /users/{user_id}/todos/{todo_id}/goodlucks)
+	DeleteUsersUserIdTodosTodoIdGoodlucks(ctx context.Context, request DeleteUsersUserIdTodosTodoIdGoodlucksRequestObject) (DeleteUsersUserIdTodosTodoIdGoodlucksResponseObject, error)
+	// いいね作成
+	// (POST /users/{user_id}/todos/{todo_id}/goodlucks)
+	PostUsersUserIdTodosTodoIdGoodlucks(ctx context.Context, request PostUsersUserIdTodosTodoIdGoodlucksRequestObject) (PostUsersUserIdTodosTodoIdGoodlucksResponseObject, error)
+	// Todo変更履歴取得
+	// (GET /users/{user_id}/todos/{todo_id}/history)
+	GetUsersUserIdTodosTodoIdHistory(ctx context.Context, request GetUsersUserIdTodosTodoIdHistoryRequestObject) (GetUsersUserIdTodosTodoIdHistoryResponseObject, error)
+	// Todoを過去の版に戻す
+	// (POST /users/{user_id}/todos/{todo_id}/history/{rev}/revert)
+	PostUsersUserIdTodosTodoIdHistoryRevRevert(ctx context.Context, request PostUsersUserIdTodosTodoIdHistoryRevRevertRequestObject) (PostUsersUserIdTodosTodoIdHistoryRevRevertResponseObject, error)
+	// Todo一括操作
+	// (POST /users/{user_id}/todos:batch)
+	PostUsersUserIdTodosBatch(ctx context.Context, request PostUsersUserIdTodosBatchRequestObject) (PostUsersUserIdTodosBatchResponseObject, error)
+	// アクセストークン一覧取得
+	// (GET /users/{user_id}/tokens)
+	GetUsersUserIdTokens(ctx context.Context, request GetUsersUserIdTokensRequestObject) (GetUsersUserIdTokensResponseObject, error)
+	// アクセストークン作成
+	// (POST /users/{user_id}/tokens)
+	PostUsersUserIdTokens(ctx context.Context, request PostUsersUserIdTokensRequestObject) (PostUsersUserIdTokensResponseObject, error)
+	// アクセストークン失効
+	// (DELETE /users/{user_id}/tokens/{token_id})
+	DeleteUsersUserIdTokensTokenId(ctx context.Context, request DeleteUsersUserIdTokensTokenIdRequestObject) (DeleteUsersUserIdTokensTokenIdResponseObject, error)
+	// 匿名ユーザー本登録
+	// (POST /users/{user_id}/upgrade)
+	PostUsersUserIdUpgrade(ctx context.Context, request PostUsersUserIdUpgradeRequestObject) (PostUsersUserIdUpgradeResponseObject, error)
+	// ワークフロー取得
+	// (GET /users/{user_id}/workflow)
+	GetUsersUserIdWorkflow(ctx context.Context, request GetUsersUserIdWorkflowRequestObject) (GetUsersUserIdWorkflowResponseObject, error)
+	// ワークフロー設定
+	// (PUT /users/{user_id}/workflow)
+	PutUsersUserIdWorkflow(ctx context.Context, request PutUsersUserIdWorkflowRequestObject) (PutUsersUserIdWorkflowResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// PostAdminTodoStatuses operation middleware
+func (sh *strictHandler) PostAdminTodoStatuses(ctx *gin.Context) {
+	var request PostAdminTodoStatusesRequestObject
+
+	var body PostAdminTodoStatusesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminTodoStatuses(ctx, request.(PostAdminTodoStatusesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminTodoStatuses")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminTodoStatusesResponseObject); ok {
+		if err := validResponse.VisitPostAdminTodoStatusesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminUsers operation middleware
+func (sh *strictHandler) GetAdminUsers(ctx *gin.Context, params GetAdminUsersParams) {
+	var request GetAdminUsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminUsers(ctx, request.(GetAdminUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminUsers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminUsersResponseObject); ok {
+		if err := validResponse.VisitGetAdminUsersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminUsersUserIdDisable operation middleware
+func (sh *strictHandler) PostAdminUsersUserIdDisable(ctx *gin.Context, userId UserId) {
+	var request PostAdminUsersUserIdDisableRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersUserIdDisable(ctx, request.(PostAdminUsersUserIdDisableRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersUserIdDisable")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminUsersUserIdDisableResponseObject); ok {
+		if err := validResponse.VisitPostAdminUsersUserIdDisableResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminUsersUserIdEnable operation middleware
+func (sh *strictHandler) PostAdminUsersUserIdEnable(ctx *gin.Context, userId UserId) {
+	var request PostAdminUsersUserIdEnableRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminUsersUserIdEnable(ctx, request.(PostAdminUsersUserIdEnableRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminUsersUserIdEnable")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAdminUsersUserIdEnableResponseObject); ok {
+		if err := validResponse.VisitPostAdminUsersUserIdEnableResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAdminUsersUserIdTodos operation middleware
+func (sh *strictHandler) GetAdminUsersUserIdTodos(ctx *gin.Context, userId UserId) {
+	var request GetAdminUsersUserIdTodosRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminUsersUserIdTodos(ctx, request.(GetAdminUsersUserIdTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminUsersUserIdTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAdminUsersUserIdTodosResponseObject); ok {
+		if err := validResponse.VisitGetAdminUsersUserIdTodosResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostLogin operation middleware
+func (sh *strictHandler) PostLogin(ctx *gin.Context) {
+	var request PostLoginRequestObject
+
+	var body PostLoginJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
+		if err := validResponse.VisitPostLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostLogout operation middleware
+func (sh *strictHandler) PostLogout(ctx *gin.Context) {
+	var request PostLogoutRequestObject
+
+	var body PostLogoutJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLogout(ctx, request.(PostLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLogout")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostLogoutResponseObject); ok {
+		if err := validResponse.VisitPostLogoutResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostRegister operation middleware
+func (sh *strictHandler) PostRegister(ctx *gin.Context) {
+	var request PostRegisterRequestObject
+
+	var body PostRegisterJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRegister(ctx, request.(PostRegisterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRegister")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostRegisterResponseObject); ok {
+		if err := validResponse.VisitPostRegisterResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostRegisterAnonymous operation middleware
+func (sh *strictHandler) PostRegisterAnonymous(ctx *gin.Context) {
+	var request PostRegisterAnonymousRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRegisterAnonymous(ctx, request.(PostRegisterAnonymousRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRegisterAnonymous")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostRegisterAnonymousResponseObject); ok {
+		if err := validResponse.VisitPostRegisterAnonymousResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTodoStatuses operation middleware
+func (sh *strictHandler) GetTodoStatuses(ctx *gin.Context) {
+	var request GetTodoStatusesRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTodoStatuses(ctx, request.(GetTodoStatusesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTodoStatuses")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetTodoStatusesResponseObject); ok {
+		if err := validResponse.VisitGetTodoStatusesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostToken operation middleware
+func (sh *strictHandler) PostToken(ctx *gin.Context) {
+	var request PostTokenRequestObject
+
+	var body PostTokenJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostToken(ctx, request.(PostTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostTokenResponseObject); ok {
+		if err := validResponse.VisitPostTokenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUsersUserId operation middleware
+func (sh *strictHandler) GetUsersUserId(ctx *gin.Context, userId UserId) {
+	var request GetUsersUserIdRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersUserId(ctx, request.(GetUsersUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersUserId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUsersUserIdResponseObject); ok {
+		if err := validResponse.VisitGetUsersUserIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchUsersUserId operation middleware
+func (sh *strictHandler) PatchUsersUserId(ctx *gin.Context, userId UserId) {
+	var request PatchUsersUserIdRequestObject
+
+	request.UserId = userId
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/json-patch+json") {
+
+		var body PatchUsersUserIdApplicationJSONPatchPlusJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusBadRequest)
+			ctx.Error(err)
+			return
+		}
+		request.ApplicationJSONPatchPlusJSONBody = &body
+	}
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/merge-patch+json") {
+
+		var body PatchUsersUserIdApplicationMergePatchPlusJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusBadRequest)
+			ctx.Error(err)
+			return
+		}
+		request.ApplicationMergePatchPlusJSONBody = &body
+	}
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchUsersUserId(ctx, request.(PatchUsersUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchUsersUserId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PatchUsersUserIdResponseObject); ok {
+		if err := validResponse.VisitPatchUsersUserIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutUsersUserId operation middleware
+func (sh *strictHandler) PutUsersUserId(ctx *gin.Context, userId UserId) {
+	var request PutUsersUserIdRequestObject
+
+	request.UserId = userId
+
+	var body PutUsersUserIdJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutUsersUserId(ctx, request.(PutUsersUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutUsersUserId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutUsersUserIdResponseObject); ok {
+		if err := validResponse.VisitPutUsersUserIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUsersUserIdTodos operation middleware
+func (sh *strictHandler) GetUsersUserIdTodos(ctx *gin.Context, userId UserId) {
+	var request GetUsersUserIdTodosRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersUserIdTodos(ctx, request.(GetUsersUserIdTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersUserIdTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUsersUserIdTodosResponseObject); ok {
+		if err := validResponse.VisitGetUsersUserIdTodosResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersUserIdTodos operation middleware
+func (sh *strictHandler) PostUsersUserIdTodos(ctx *gin.Context, userId UserId) {
+	var request PostUsersUserIdTodosRequestObject
+
+	request.UserId = userId
+
+	var body PostUsersUserIdTodosJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersUserIdTodos(ctx, request.(PostUsersUserIdTodosRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersUserIdTodos")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostUsersUserIdTodosResponseObject); ok {
+		if err := validResponse.VisitPostUsersUserIdTodosResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteUsersUserIdTodosTodoId operation middleware
+func (sh *strictHandler) DeleteUsersUserIdTodosTodoId(ctx *gin.Context, userId UserId, todoId TodoId) {
+	var request DeleteUsersUserIdTodosTodoIdRequestObject
+
+	request.UserId = userId
+	request.TodoId = todoId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUsersUserIdTodosTodoId(ctx, request.(DeleteUsersUserIdTodosTodoIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUsersUserIdTodosTodoId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteUsersUserIdTodosTodoIdResponseObject); ok {
+		if err := validResponse.VisitDeleteUsersUserIdTodosTodoIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUsersUserIdTodosTodoId operation middleware
+func (sh *strictHandler) GetUsersUserIdTodosTodoId(ctx *gin.Context, userId UserId, todoId TodoId) {
+	var request GetUsersUserIdTodosTodoIdRequestObject
+
+	request.UserId = userId
+	request.TodoId = todoId
 
-type PostUsersUserIdTodosTodoIdGoodlucks404JSONResponse struct{ NotFoundJSONResponse }
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersUserIdTodosTodoId(ctx, request.(GetUsersUserIdTodosTodoIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersUserIdTodosTodoId")
+	}
 
-func (response PostUsersUserIdTodosTodoIdGoodlucks404JSONResponse) VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUsersUserIdTodosTodoIdResponseObject); ok {
+		if err := validResponse.VisitGetUsersUserIdTodosTodoIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostUsersUserIdTodosTodoIdGoodlucks500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// PatchUsersUserIdTodosTodoId operation middleware
+func (sh *strictHandler) PatchUsersUserIdTodosTodoId(ctx *gin.Context, userId UserId, todoId TodoId) {
+	var request PatchUsersUserIdTodosTodoIdRequestObject
 
-func (response PostUsersUserIdTodosTodoIdGoodlucks500JSONResponse) VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.UserId = userId
+	request.TodoId = todoId
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/json-patch+json") {
 
-	return json.NewEncoder(w).Encode(response)
-}
+		var body PatchUsersUserIdTodosTodoIdApplicationJSONPatchPlusJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusBadRequest)
+			ctx.Error(err)
+			return
+		}
+		request.ApplicationJSONPatchPlusJSONBody = &body
+	}
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/merge-patch+json") {
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// ログイン
-	// (POST /login)
-	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
-	// ログアウト
-	// (POST /logout)
-	PostLogout(ctx context.Context, request PostLogoutRequestObject) (PostLogoutResponseObject, error)
-	// ユーザー登録
-	// (POST /register)
-	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
-	// ユーザー詳細取得
-	// (GET /users/{user_id})
-	GetUsersUserId(ctx context.Context, request GetUsersUserIdRequestObject) (GetUsersUserIdResponseObject, error)
-	// ユーザー情報編集
-	// (PUT /users/{user_id})
-	PutUsersUserId(ctx context.Context, request PutUsersUserIdRequestObject) (PutUsersUserIdResponseObject, error)
-	// Todo一覧取得
-	// (GET /users/{user_id}/todos)
-	GetUsersUserIdTodos(ctx context.Context, request GetUsersUserIdTodosRequestObject) (GetUsersUserIdTodosResponseObject, error)
-	// Todo作成
-	// (POST /users/{user_id}/todos)
-	PostUsersUserIdTodos(ctx context.Context, request PostUsersUserIdTodosRequestObject) (PostUsersUserIdTodosResponseObject, error)
-	// Todo削除
-	// (DELETE /users/{user_id}/todos/{todo_id})
-	DeleteUsersUserIdTodosTodoId(ctx context.Context, request DeleteUsersUserIdTodosTodoIdRequestObject) (DeleteUsersUserIdTodosTodoIdResponseObject, error)
-	// Todo詳細取得
-	// (GET /users/{user_id}/todos/{todo_id})
-	GetUsersUserIdTodosTodoId(ctx context.Context, request GetUsersUserIdTodosTodoIdRequestObject) (GetUsersUserIdTodosTodoIdResponseObject, error)
-	// Todo編集
-	// (PUT /users/{user_id}/todos/{todo_id})
-	PutUsersUserIdTodosTodoId(ctx context.Context, request PutUsersUserIdTodosTodoIdRequestObject) (PutUsersUserIdTodosTodoIdResponseObject, error)
-	// いいね削除
-	// (DELETE /users/{user_id}/todos/{todo_id}/goodlucks)
-	DeleteUsersUserIdTodosTodoIdGoodlucks(ctx context.Context, request DeleteUsersUserIdTodosTodoIdGoodlucksRequestObject) (DeleteUsersUserIdTodosTodoIdGoodlucksResponseObject, error)
-	// いいね作成
-	// (POST /users/{user_id}/todos/{todo_id}/goodlucks)
-	PostUsersUserIdTodosTodoIdGoodlucks(ctx context.Context, request PostUsersUserIdTodosTodoIdGoodlucksRequestObject) (PostUsersUserIdTodosTodoIdGoodlucksResponseObject, error)
-}
+		var body PatchUsersUserIdTodosTodoIdApplicationMergePatchPlusJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusBadRequest)
+			ctx.Error(err)
+			return
+		}
+		request.ApplicationMergePatchPlusJSONBody = &body
+	}
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchUsersUserIdTodosTodoId(ctx, request.(PatchUsersUserIdTodosTodoIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchUsersUserIdTodosTodoId")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	response, err := handler(ctx, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PatchUsersUserIdTodosTodoIdResponseObject); ok {
+		if err := validResponse.VisitPatchUsersUserIdTodosTodoIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostLogin operation middleware
-func (sh *strictHandler) PostLogin(ctx *gin.Context) {
-	var request PostLoginRequestObject
+// PutUsersUserIdTodosTodoId operation middleware
+func (sh *strictHandler) PutUsersUserIdTodosTodoId(ctx *gin.Context, userId UserId, todoId TodoId) {
+	var request PutUsersUserIdTodosTodoIdRequestObject
 
-	var body PostLoginJSONRequestBody
+	request.UserId = userId
+	request.TodoId = todoId
+
+	var body PutUsersUserIdTodosTodoIdJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1413,10 +4518,10 @@ func (sh *strictHandler) PostLogin(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+		return sh.ssi.PutUsersUserIdTodosTodoId(ctx, request.(PutUsersUserIdTodosTodoIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostLogin")
+		handler = middleware(handler, "PutUsersUserIdTodosTodoId")
 	}
 
 	response, err := handler(ctx, request)
@@ -1424,8 +4529,8 @@ func (sh *strictHandler) PostLogin(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
-		if err := validResponse.VisitPostLoginResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PutUsersUserIdTodosTodoIdResponseObject); ok {
+		if err := validResponse.VisitPutUsersUserIdTodosTodoIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1433,23 +4538,18 @@ func (sh *strictHandler) PostLogin(ctx *gin.Context) {
 	}
 }
 
-// PostLogout operation middleware
-func (sh *strictHandler) PostLogout(ctx *gin.Context) {
-	var request PostLogoutRequestObject
+// DeleteUsersUserIdTodosTodoIdGoodlucks operation middleware
+func (sh *strictHandler) DeleteUsersUserIdTodosTodoIdGoodlucks(ctx *gin.Context, userId UserId, todoId TodoId) {
+	var request DeleteUsersUserIdTodosTodoIdGoodlucksRequestObject
 
-	var body PostLogoutJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.UserId = userId
+	request.TodoId = todoId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostLogout(ctx, request.(PostLogoutRequestObject))
+		return sh.ssi.DeleteUsersUserIdTodosTodoIdGoodlucks(ctx, request.(DeleteUsersUserIdTodosTodoIdGoodlucksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostLogout")
+		handler = middleware(handler, "DeleteUsersUserIdTodosTodoIdGoodlucks")
 	}
 
 	response, err := handler(ctx, request)
@@ -1457,8 +4557,8 @@ func (sh *strictHandler) PostLogout(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostLogoutResponseObject); ok {
-		if err := validResponse.VisitPostLogoutResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteUsersUserIdTodosTodoIdGoodlucksResponseObject); ok {
+		if err := validResponse.VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1466,11 +4566,14 @@ func (sh *strictHandler) PostLogout(ctx *gin.Context) {
 	}
 }
 
-// PostRegister operation middleware
-func (sh *strictHandler) PostRegister(ctx *gin.Context) {
-	var request PostRegisterRequestObject
+// PostUsersUserIdTodosTodoIdGoodlucks operation middleware
+func (sh *strictHandler) PostUsersUserIdTodosTodoIdGoodlucks(ctx *gin.Context, userId UserId, todoId TodoId) {
+	var request PostUsersUserIdTodosTodoIdGoodlucksRequestObject
 
-	var body PostRegisterJSONRequestBody
+	request.UserId = userId
+	request.TodoId = todoId
+
+	var body PostUsersUserIdTodosTodoIdGoodlucksJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1479,10 +4582,10 @@ func (sh *strictHandler) PostRegister(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostRegister(ctx, request.(PostRegisterRequestObject))
+		return sh.ssi.PostUsersUserIdTodosTodoIdGoodlucks(ctx, request.(PostUsersUserIdTodosTodoIdGoodlucksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostRegister")
+		handler = middleware(handler, "PostUsersUserIdTodosTodoIdGoodlucks")
 	}
 
 	response, err := handler(ctx, request)
@@ -1490,8 +4593,8 @@ func (sh *strictHandler) PostRegister(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostRegisterResponseObject); ok {
-		if err := validResponse.VisitPostRegisterResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersUserIdTodosTodoIdGoodlucksResponseObject); ok {
+		if err := validResponse.VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1499,17 +4602,18 @@ func (sh *strictHandler) PostRegister(ctx *gin.Context) {
 	}
 }
 
-// GetUsersUserId operation middleware
-func (sh *strictHandler) GetUsersUserId(ctx *gin.Context, userId UserId) {
-	var request GetUsersUserIdRequestObject
+// GetUsersUserIdTodosTodoIdHistory operation middleware
+func (sh *strictHandler) GetUsersUserIdTodosTodoIdHistory(ctx *gin.Context, userId UserId, todoId TodoId) {
+	var request GetUsersUserIdTodosTodoIdHistoryRequestObject
 
 	request.UserId = userId
+	request.TodoId = todoId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUsersUserId(ctx, request.(GetUsersUserIdRequestObject))
+		return sh.ssi.GetUsersUserIdTodosTodoIdHistory(ctx, request.(GetUsersUserIdTodosTodoIdHistoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUsersUserId")
+		handler = middleware(handler, "GetUsersUserIdTodosTodoIdHistory")
 	}
 
 	response, err := handler(ctx, request)
@@ -1517,8 +4621,8 @@ func (sh *strictHandler) GetUsersUserId(ctx *gin.Context, userId UserId) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetUsersUserIdResponseObject); ok {
-		if err := validResponse.VisitGetUsersUserIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetUsersUserIdTodosTodoIdHistoryResponseObject); ok {
+		if err := validResponse.VisitGetUsersUserIdTodosTodoIdHistoryResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1526,13 +4630,15 @@ func (sh *strictHandler) GetUsersUserId(ctx *gin.Context, userId UserId) {
 	}
 }
 
-// PutUsersUserId operation middleware
-func (sh *strictHandler) PutUsersUserId(ctx *gin.Context, userId UserId) {
-	var request PutUsersUserIdRequestObject
+// PostUsersUserIdTodosTodoIdHistoryRevRevert operation middleware
+func (sh *strictHandler) PostUsersUserIdTodosTodoIdHistoryRevRevert(ctx *gin.Context, userId UserId, todoId TodoId, rev Rev) {
+	var request PostUsersUserIdTodosTodoIdHistoryRevRevertRequestObject
 
 	request.UserId = userId
+	request.TodoId = todoId
+	request.Rev = rev
 
-	var body PutUsersUserIdJSONRequestBody
+	var body PostUsersUserIdTodosTodoIdHistoryRevRevertJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1541,10 +4647,10 @@ func (sh *strictHandler) PutUsersUserId(ctx *gin.Context, userId UserId) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutUsersUserId(ctx, request.(PutUsersUserIdRequestObject))
+		return sh.ssi.PostUsersUserIdTodosTodoIdHistoryRevRevert(ctx, request.(PostUsersUserIdTodosTodoIdHistoryRevRevertRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutUsersUserId")
+		handler = middleware(handler, "PostUsersUserIdTodosTodoIdHistoryRevRevert")
 	}
 
 	response, err := handler(ctx, request)
@@ -1552,8 +4658,8 @@ func (sh *strictHandler) PutUsersUserId(ctx *gin.Context, userId UserId) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PutUsersUserIdResponseObject); ok {
-		if err := validResponse.VisitPutUsersUserIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersUserIdTodosTodoIdHistoryRevRevertResponseObject); ok {
+		if err := validResponse.VisitPostUsersUserIdTodosTodoIdHistoryRevRevertResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1561,17 +4667,25 @@ func (sh *strictHandler) PutUsersUserId(ctx *gin.Context, userId UserId) {
 	}
 }
 
-// GetUsersUserIdTodos operation middleware
-func (sh *strictHandler) GetUsersUserIdTodos(ctx *gin.Context, userId UserId) {
-	var request GetUsersUserIdTodosRequestObject
+// PostUsersUserIdTodosBatch operation middleware
+func (sh *strictHandler) PostUsersUserIdTodosBatch(ctx *gin.Context, userId UserId) {
+	var request PostUsersUserIdTodosBatchRequestObject
 
 	request.UserId = userId
 
+	var body PostUsersUserIdTodosBatchJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUsersUserIdTodos(ctx, request.(GetUsersUserIdTodosRequestObject))
+		return sh.ssi.PostUsersUserIdTodosBatch(ctx, request.(PostUsersUserIdTodosBatchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUsersUserIdTodos")
+		handler = middleware(handler, "PostUsersUserIdTodosBatch")
 	}
 
 	response, err := handler(ctx, request)
@@ -1579,8 +4693,8 @@ func (sh *strictHandler) GetUsersUserIdTodos(ctx *gin.Context, userId UserId) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetUsersUserIdTodosResponseObject); ok {
-		if err := validResponse.VisitGetUsersUserIdTodosResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersUserIdTodosBatchResponseObject); ok {
+		if err := validResponse.VisitPostUsersUserIdTodosBatchResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1588,25 +4702,17 @@ func (sh *strictHandler) GetUsersUserIdTodos(ctx *gin.Context, userId UserId) {
 	}
 }
 
-// PostUsersUserIdTodos operation middleware
-func (sh *strictHandler) PostUsersUserIdTodos(ctx *gin.Context, userId UserId) {
-	var request PostUsersUserIdTodosRequestObject
+// GetUsersUserIdTokens operation middleware
+func (sh *strictHandler) GetUsersUserIdTokens(ctx *gin.Context, userId UserId) {
+	var request GetUsersUserIdTokensRequestObject
 
 	request.UserId = userId
 
-	var body PostUsersUserIdTodosJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostUsersUserIdTodos(ctx, request.(PostUsersUserIdTodosRequestObject))
+		return sh.ssi.GetUsersUserIdTokens(ctx, request.(GetUsersUserIdTokensRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostUsersUserIdTodos")
+		handler = middleware(handler, "GetUsersUserIdTokens")
 	}
 
 	response, err := handler(ctx, request)
@@ -1614,8 +4720,8 @@ func (sh *strictHandler) PostUsersUserIdTodos(ctx *gin.Context, userId UserId) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostUsersUserIdTodosResponseObject); ok {
-		if err := validResponse.VisitPostUsersUserIdTodosResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetUsersUserIdTokensResponseObject); ok {
+		if err := validResponse.VisitGetUsersUserIdTokensResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1623,18 +4729,25 @@ func (sh *strictHandler) PostUsersUserIdTodos(ctx *gin.Context, userId UserId) {
 	}
 }
 
-// DeleteUsersUserIdTodosTodoId operation middleware
-func (sh *strictHandler) DeleteUsersUserIdTodosTodoId(ctx *gin.Context, userId UserId, todoId TodoId) {
-	var request DeleteUsersUserIdTodosTodoIdRequestObject
+// PostUsersUserIdTokens operation middleware
+func (sh *strictHandler) PostUsersUserIdTokens(ctx *gin.Context, userId UserId) {
+	var request PostUsersUserIdTokensRequestObject
 
 	request.UserId = userId
-	request.TodoId = todoId
+
+	var body PostUsersUserIdTokensJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteUsersUserIdTodosTodoId(ctx, request.(DeleteUsersUserIdTodosTodoIdRequestObject))
+		return sh.ssi.PostUsersUserIdTokens(ctx, request.(PostUsersUserIdTokensRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteUsersUserIdTodosTodoId")
+		handler = middleware(handler, "PostUsersUserIdTokens")
 	}
 
 	response, err := handler(ctx, request)
@@ -1642,8 +4755,8 @@ func (sh *strictHandler) DeleteUsersUserIdTodosTodoId(ctx *gin.Context, userId U
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteUsersUserIdTodosTodoIdResponseObject); ok {
-		if err := validResponse.VisitDeleteUsersUserIdTodosTodoIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersUserIdTokensResponseObject); ok {
+		if err := validResponse.VisitPostUsersUserIdTokensResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1651,18 +4764,18 @@ func (sh *strictHandler) DeleteUsersUserIdTodosTodoId(ctx *gin.Context, userId U
 	}
 }
 
-// GetUsersUserIdTodosTodoId operation middleware
-func (sh *strictHandler) GetUsersUserIdTodosTodoId(ctx *gin.Context, userId UserId, todoId TodoId) {
-	var request GetUsersUserIdTodosTodoIdRequestObject
+// DeleteUsersUserIdTokensTokenId operation middleware
+func (sh *strictHandler) DeleteUsersUserIdTokensTokenId(ctx *gin.Context, userId UserId, tokenId TokenId) {
+	var request DeleteUsersUserIdTokensTokenIdRequestObject
 
 	request.UserId = userId
-	request.TodoId = todoId
+	request.TokenId = tokenId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUsersUserIdTodosTodoId(ctx, request.(GetUsersUserIdTodosTodoIdRequestObject))
+		return sh.ssi.DeleteUsersUserIdTokensTokenId(ctx, request.(DeleteUsersUserIdTokensTokenIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUsersUserIdTodosTodoId")
+		handler = middleware(handler, "DeleteUsersUserIdTokensTokenId")
 	}
 
 	response, err := handler(ctx, request)
@@ -1670,8 +4783,8 @@ func (sh *strictHandler) GetUsersUserIdTodosTodoId(ctx *gin.Context, userId User
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetUsersUserIdTodosTodoIdResponseObject); ok {
-		if err := validResponse.VisitGetUsersUserIdTodosTodoIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteUsersUserIdTokensTokenIdResponseObject); ok {
+		if err := validResponse.VisitDeleteUsersUserIdTokensTokenIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1679,14 +4792,13 @@ func (sh *strictHandler) GetUsersUserIdTodosTodoId(ctx *gin.Context, userId User
 	}
 }
 
-// PutUsersUserIdTodosTodoId operation middleware
-func (sh *strictHandler) PutUsersUserIdTodosTodoId(ctx *gin.Context, userId UserId, todoId TodoId) {
-	var request PutUsersUserIdTodosTodoIdRequestObject
+// PostUsersUserIdUpgrade operation middleware
+func (sh *strictHandler) PostUsersUserIdUpgrade(ctx *gin.Context, userId UserId) {
+	var request PostUsersUserIdUpgradeRequestObject
 
 	request.UserId = userId
-	request.TodoId = todoId
 
-	var body PutUsersUserIdTodosTodoIdJSONRequestBody
+	var body PostUsersUserIdUpgradeJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1695,10 +4807,10 @@ func (sh *strictHandler) PutUsersUserIdTodosTodoId(ctx *gin.Context, userId User
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutUsersUserIdTodosTodoId(ctx, request.(PutUsersUserIdTodosTodoIdRequestObject))
+		return sh.ssi.PostUsersUserIdUpgrade(ctx, request.(PostUsersUserIdUpgradeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutUsersUserIdTodosTodoId")
+		handler = middleware(handler, "PostUsersUserIdUpgrade")
 	}
 
 	response, err := handler(ctx, request)
@@ -1706,8 +4818,8 @@ func (sh *strictHandler) PutUsersUserIdTodosTodoId(ctx *gin.Context, userId User
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PutUsersUserIdTodosTodoIdResponseObject); ok {
-		if err := validResponse.VisitPutUsersUserIdTodosTodoIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersUserIdUpgradeResponseObject); ok {
+		if err := validResponse.VisitPostUsersUserIdUpgradeResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1715,18 +4827,17 @@ func (sh *strictHandler) PutUsersUserIdTodosTodoId(ctx *gin.Context, userId User
 	}
 }
 
-// DeleteUsersUserIdTodosTodoIdGoodlucks operation middleware
-func (sh *strictHandler) DeleteUsersUserIdTodosTodoIdGoodlucks(ctx *gin.Context, userId UserId, todoId TodoId) {
-	var request DeleteUsersUserIdTodosTodoIdGoodlucksRequestObject
+// GetUsersUserIdWorkflow operation middleware
+func (sh *strictHandler) GetUsersUserIdWorkflow(ctx *gin.Context, userId UserId) {
+	var request GetUsersUserIdWorkflowRequestObject
 
 	request.UserId = userId
-	request.TodoId = todoId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteUsersUserIdTodosTodoIdGoodlucks(ctx, request.(DeleteUsersUserIdTodosTodoIdGoodlucksRequestObject))
+		return sh.ssi.GetUsersUserIdWorkflow(ctx, request.(GetUsersUserIdWorkflowRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteUsersUserIdTodosTodoIdGoodlucks")
+		handler = middleware(handler, "GetUsersUserIdWorkflow")
 	}
 
 	response, err := handler(ctx, request)
@@ -1734,8 +4845,8 @@ func (sh *strictHandler) DeleteUsersUserIdTodosTodoIdGoodlucks(ctx *gin.Context,
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteUsersUserIdTodosTodoIdGoodlucksResponseObject); ok {
-		if err := validResponse.VisitDeleteUsersUserIdTodosTodoIdGoodlucksResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetUsersUserIdWorkflowResponseObject); ok {
+		if err := validResponse.VisitGetUsersUserIdWorkflowResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1743,14 +4854,13 @@ func (sh *strictHandler) DeleteUsersUserIdTodosTodoIdGoodlucks(ctx *gin.Context,
 	}
 }
 
-// PostUsersUserIdTodosTodoIdGoodlucks operation middleware
-func (sh *strictHandler) PostUsersUserIdTodosTodoIdGoodlucks(ctx *gin.Context, userId UserId, todoId TodoId) {
-	var request PostUsersUserIdTodosTodoIdGoodlucksRequestObject
+// PutUsersUserIdWorkflow operation middleware
+func (sh *strictHandler) PutUsersUserIdWorkflow(ctx *gin.Context, userId UserId) {
+	var request PutUsersUserIdWorkflowRequestObject
 
 	request.UserId = userId
-	request.TodoId = todoId
 
-	var body PostUsersUserIdTodosTodoIdGoodlucksJSONRequestBody
+	var body PutUsersUserIdWorkflowJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1759,10 +4869,10 @@ func (sh *strictHandler) PostUsersUserIdTodosTodoIdGoodlucks(ctx *gin.Context, u
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostUsersUserIdTodosTodoIdGoodlucks(ctx, request.(PostUsersUserIdTodosTodoIdGoodlucksRequestObject))
+		return sh.ssi.PutUsersUserIdWorkflow(ctx, request.(PutUsersUserIdWorkflowRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostUsersUserIdTodosTodoIdGoodlucks")
+		handler = middleware(handler, "PutUsersUserIdWorkflow")
 	}
 
 	response, err := handler(ctx, request)
@@ -1770,8 +4880,8 @@ func (sh *strictHandler) PostUsersUserIdTodosTodoIdGoodlucks(ctx *gin.Context, u
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostUsersUserIdTodosTodoIdGoodlucksResponseObject); ok {
-		if err := validResponse.VisitPostUsersUserIdTodosTodoIdGoodlucksResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PutUsersUserIdWorkflowResponseObject); ok {
+		if err := validResponse.VisitPutUsersUserIdWorkflowResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1782,36 +4892,126 @@ func (sh *strictHandler) PostUsersUserIdTodosTodoIdGoodlucks(ctx *gin.Context, u
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xa72/Txhv/V6L78uI7yWD3x1Dnd2OlCFQBgla8KB26xtfEEPvM3bmQVZFah1UFKg1V",
-	"2lg1UAdibDCBGEwbjDL+GLdp+4p/Ybo7J3ESOz/qpGVa3jSp7bvneT7P5z73POfMgzS2HGwjm1GgzwMH",
-	"Emghhoj4j2EDXzIN/tW0gQ4cyLJAATa0ENArdxVA0FXXJMgAOiMuUgBNZ5EF+bBZTCzIgA7SWUj+P3T0",
-	"E6AAlnf4cMqIaWdAoaAAlyISb6d8t307gyNRdgp8AupgmyIR3TFonENXXUQZ/y+NbYZs8RU6Ts5MQ2Zi",
-	"W71Msc2vVU2h69Byckh8JQQToAPTnoM500gROV1qBht5UFCAQ7CDCDOlveDp+QgAgit45jJKM+mqgWia",
-	"mA53Aujc11TZ2YICxjCZMQ0D2Uk9n61M1FV3x8LTnrQZIjbMnUdkDpHj5WmTAS6nTFExZ0pe72oIZa9T",
-	"0u3U8bKJ05iNYdc2ksZgY5aaFRN11e/TmKXGytNO2tBlWUzMr1Bif93wXF11ucZLflv6Ieb9giDI0AmM",
-	"jZybvhJar7XmQ0rVhuTUKE470tEQhNLgmBSWRs8sRCnMoLagKc86gQ0cG2oohxa8Po7sDMsCfUDTtIg4",
-	"DRddMiBDzLSEC4cImgU6+J9alX01QFvlVkddNFp+nCeCQebSdgael0/ymEwmiRPybkhTgGXaFWeVTrGI",
-	"Q7ftlEcZOIGYiBkxaObibfxnAA/wGDcpC6NhMmTRRlgSRtrBYj1QUIILkBCYD0CapIi0Ig2yoJmriVBe",
-	"iQjPNtNXZKnTlkSM44xpcxdiFaID2w6k9BomtbmoXFTC6A3WojeidOhsHFIwnUaUXmL4iixnKm5cvsai",
-	"XCZoliCabX+Am0jnx3EGu6wp3sk3k7CR7mwk51DGpAyRbhElTNJ4UgwcAMVqI/2XsqxeILm+1tRIpXvr",
-	"u2t3Snd/Kq15HzaW8/l8XrUs1TBS2axuWR82bgKlWrWBQW3wqKoNqNpQSvtMH9IAzwPjFS3QwZcXLxrz",
-	"wwWVfwyWP1LyQ5cfh6JCDClsg3/8nr/43Pfe+MUlv7jhe+997w33yXYtoE+B0r2n2/cXSjdvAwXsLrzc",
-	"ebCy+foZUMDW85XNv5aAAjbf39/+dg1MRxiedIx+VRQwJYxFT6oiaeCgdaOVZ/u97/K0o7RLTJY/zxMs",
-	"rc0gSBCpfhsrGz11YQLUtzmnLkykJricpD53WRbZLGi/QNDwcHPBhBXzWcYc2TKZ9ixuXHa+99D3fvW9",
-	"x37xlV9c3l57u7vysvRkfaf4zl9c2Xz7vb94w19cnzgzesYvPhWrc9n3Vjff3fO92/6CByrMBBl8OGPa",
-	"h6+hGeiYQAFziFBpZOCIdkTjiGEH2fymDobEJSEqWYGEmuM7vcgHlpSpc7T4zPde+N4j7qi3uvNgxV9c",
-	"kg7w9AkgThpAB2cxZaJqCM57EGXHsJHvqHtttiwbyqfgYCh8slR/UjSoDfTCfsDhiI44jFZp+c7WrXUO",
-	"/7Cmxc1ecVcNnWoVFPBpO0OiDmgE4V3LgiRf54+4xdONXdY63w8FN5fbSTmfr2c5r6vh9j/p9fVdk6wH",
-	"mCVK/LD0vvmQybojnWRsCeQR6FNVYZyaLkxHESkIUXKJBBVcMzY9FjXFH35xQ2pcS0KVy8IeUSqqvt5n",
-	"UkUWvpG0qgfv45CUeq8kGXgzRdX5oKcqcFMZ1IISO09ebf/+wvdWt775buvvu/7iWmV3qyVG0LxT/uek",
-	"Ifav6vuOqeigqo+o5UaPc7ourVrX0hp9wNAirxIBGf7+68awNtR6UM07gWFtuPWIykn7PklTNJyih3Vb",
-	"MLBU/Hrrx998b3X7z192f1iKZ+BZt4sM7L6sNRb/bYma1hMH2qO+hF7i3qd+YuqH4YxUZJVhA9NYXead",
-	"6ebrhZ3HP3esxxNi4o9VlBuOxiN4WQ2+L8UJ+FgPo5DgyMJQHDuJfra0fKeJ6mLabaJ1X3sb3/3tc0EZ",
-	"8cItjuMC7z67985uAWATfVXng1faBUn6HGIolv5bN2/trj2Kp/+oGF6/APifJCWI0vLR8lv5CGkejg5G",
-	"RtIn1p6JJQHk9mJ35z12SwfPma5v5617rCpe/Q09ITPb6qkCQeusizoQZvaq9+p4/9d64kDzJdHvthIu",
-	"hpb9VXX/VzPBb8xos0pAvOe54S8+S1gOnKgY+2jqgkpo/eIgSY9fi2J8SxVm0l76qgPjUa86svofnx5I",
-	"V9bwQ9OoU7Fy5vrtWTcWSqVHEyP5TJK/LskFb+Z1Vc3hNMxlMWX6iDaiqdAx1bkBUJgu/BMAAP//Haaj",
-	"3VoxAAA=",
+	"H4sIAAAAAAAC/+xd63fTxrb/V7R0z4d71zXYCekDr3U/tOXQw1l9LQr3fIDcVMSTRMWWfCQZyGFlrUgm",
+	"qfNqQloeOaQNaUMSEnCgpRRIgD9Gke18yr9w154ZyXqMLDlxEmjzBWJbmseePXv2/u3HXOW75VxelpCk",
+	"qXz6Kp8XFCGHNKTgT1kxJ2rwhyjxaf6fBaT08wleEnKIT9MfE7za3YdyAjyVQT1CIavx6XdSCT4nXBFz",
+	"hRyfbkvBJ1GinxK81p+H90VJQ71I4QcGErzc06Oi0J7or8yu3G2nmG0r6JLTcF7Q+urtwi/w+z8LooIy",
+	"fFpTCsjdScSgNTkjd4mZkMbtXxt10CMrOUHj03x3n6D857F3/4t3OlI1RZR6aT8XkdSoI/rz7nsqqEgJ",
+	"78j+NX4/7e+z+sFrouZlSUWYyz4UMqfRPwtIxQzQLUsakvCfQj6fFbsFTZSlZF6RL2RR7r+/VmUJfkNX",
+	"hFw+i8gbGWj/kpAVM/jhrh5BzCIYaAZpgpgFIvUhTiGdcH2CyokSfpzrEVE2o/IJHimKDEx/7iqPv4N3",
+	"RC2L+ASfQ6oq9EIXlKc5MhUui6RerY8TVe5YCqhSyNKHPsE/8AOdCV6UVE2QuuGHpJAXk5fakkBHNXnl",
+	"ypUrSWAS6F3VBK2g8ukO2Cuk3zT/v86EOGdClJYFRUr3ykd6RenIZXRByItpSp90kAoD7vX5i4J6+DT/",
+	"H8n6tk+SX9XkF6QJskIZpHYrYh4a4tO8WVwxjTXTWDaN52axZOrjm88mKg9/3t4obd0dqt4pm/r3pr5s",
+	"6mV4pnjfLG6Y+hpHiLq9MQKDOCkrF8RMBkm7WuUepxU31Y7VqXbS9UAkteqttYJKJ92tnZI0pEhC9kuk",
+	"XELKX4ESu5q4SNvrwkR1zf4dN8/YvXIq7pazH9YUoRvhrc13XOg53t5z7J333rtwrCMjvCsc60bH249n",
+	"UiiFOt479m4cuvkG0wriOSMnBOMwxbY3Sta3c9adu8BN9hw4U18yiw9N45Gpr9aWb2+N/2Lqt8xB3Sw+",
+	"wPz5g1n8Ff7QV019zZpaNQ3d1FdM/RrlxM9k7aRckDK7Wg9J1rp6cCtuRuyoL8VnssbZD0QStN5aK2gJ",
+	"XZ+0W/tC0Lr7ziBVO0nkwW4mnYe2ujSkanUZW5/88frk//7l559xuGcOnm5CgAW7aAVFXOMx9TIZU+W7",
+	"ic2Xs6Y+bi08rty4BTykz0FvX+IZnUaCKkunnaNuJ2QTsln5Msp0ERohOF94qzy++WKYT/Cbr3+o3pjh",
+	"OxM2dclTXQruuMs5ZF3HmOcJOHjshzhN5nLyJQT/03ZdK9PeXl8ZMjmONuHqJHJlQoYXe3lIz2cUQVJF",
+	"IByRiazTRv8OnyTPzeIwnCTGa9N4vqX/Xl1aJ3u6OjVc/f7x9kbJM6TtjRFYzNdDtUW9voz1/j6TtQ/I",
+	"erRuMbcGf6nNj28+exhcRs3puAt2N307uJpc/UGuR5FzXGV2pfrDYGVkDBaTcAsstSRrXL0R5q77MtCg",
+	"96W4Sxwy9NavdHXylTW7HFxsUx8zjRFTX7NXfcnUJ4gMh1GckeVPBamfao3qrkSaImioC9syXrq2u+h6",
+	"Rpa5nCD12yqkGoeWnoZbIcNgFDBt7nR9FH1IyFBj7TTSlP4jH/RoSCEWkvtda3iidn+xNj9u6q8wNcvV",
+	"penKjUceoypg48AYzkpCQeuTFfFfuzw7Cu6G3AzcVif0We8zkTT2tNkKGp/1NXhWUgv5vKxoKPMpyojC",
+	"mf48ndOOqeA02JWDFrvwJF30aHvHTQ/naQ4/zdGnY1CG2U8raPQRmfsRoAVn6uNEXNun6ZI1ecvUr2+u",
+	"3zb166YxZhoG7G/guTV7BzujwIz7QXc3UtUzYMbCx7wi55GiicQ47FaQAHMQNI95mRE0dEQTcyhoXyZ4",
+	"dCUvKkht6h0x43k23ExO8FlB1boKapODIkb01eAParecJ3MVNZRToxbGRa0v4U1ogrYpKIrQzw/Uv5Av",
+	"fI26NXjC9dYnoqqdpvb3TnoNdpjgA4MKCCDT+AlbkOvUggRJvwZ6OpH9xq/wTfEWn+CRBJDLOQygqGkF",
+	"CVgS4A+XFVFDfKfTf52IH2RyonRWRUqQgzKiKlygii9974IsZ5GAZ4Jy+Cx2rSL5hrWCYvfF0FUsiJm4",
+	"+Adreezh72xxnMkzluZDrP7LGfnzPFIEshrsTRbVzUf4KWjKhmsGErych9fsNaMNJfhCPkP+yKAsClkz",
+	"F37m5RXyMpfkyMugsVtrr2qP5/lEvB1Ke4+Yz9l8xj+fATe2dQ4m18lYLYekLtjKS1BBk3NitweoJEiZ",
+	"d6LwHQdC0RixhpY3159WSlPW6ByxRKy7T6ypEpadr63Jicrtu6Y+YxpjfILBxrK9uPEFCYMxBjBse4q8",
+	"beO29keGmPHSyhlBBM3q3O3jQjmXEzUNMTjCWhip3HkC2j0lxA3TGDf1OVNnk0NBaiGr7YAWp/GL8WSq",
+	"/6UgFwQMhqBUZNo445WxaWtq0ZkmYYXtjVJH6jiX5Dra27GtUybPW5NrteJLzEae1vhEvMnDFIjGzpIf",
+	"yEauQo5M30Lhfbq9USKSAG9dysdrmy9nK6UpZ1LbGyPQ86kTrB1sa0OB9gfHrNI9MOE/+OIUZxrT1vUN",
+	"0/jO1O8624X725kzX3B+g4IeMCM8G8r3ry2Rda5jLXSre5UN72grsyPW6PPK7NzWzNT2Rqk6q1dv3KvM",
+	"GGDCXpu3vx9xi7VYOkQdYiauFlGyP7Yl9kTBiC8G8BCdPjvjkTZUIrx5yt/+qXHU8cMQGi71qTL7YPPl",
+	"d9sbJYqZ+BHQJXJ+1F5/T/edMUYYLoZSQhbqY1nOZAvdF0M3gOsYj3c4151MO9SW/AMLYx/HcXM1fqsN",
+	"j3WX1efagm2pVIoxz0wBdQHXYaaLIYJPFNAJ+3GPBIwvuyl8Q0zNuC9+BE/XXyZYGkPyln6ozM4FcJqy",
+	"g8VVbv1klf+9uX7PWrjJQnSWbPkPUD096YqrWCqvgtn4emjrbsk5LwiXuqjcziQyNZQ963EsSiT6RJbt",
+	"57NXtzOCOcLYLfYeaMx9NvocwoOi2qUhJSdKQpZt0aiyonXJSoYFA9Xml6sLL7buDvtOo8rsqvXoFSgV",
+	"g0bl5qPa4iSMBKx6vKjW4AKGyUdMYxLjcmDGm0bJ1IfNQZ08w1hyo2Qao9YCwe6u4UZW/Tqscwq7Od6z",
+	"7NHn28653scJdAQsBjgJTmHHi+ddEuow9hObeEbBhTV0zzTGrYdTAIEUR8xi0SyWrPEXVukb0xg1Bw3q",
+	"Wy2umMV1s3gd0/E6dqPOU2oCdvIDbI6pCWtkgmwOB1xy6d5HU0fJoXnU5uoAuVxSsd5CY892oA3i6g6o",
+	"Oy8GsU5+y9QXgUEwm1ilp9Un17Y3So6rIsk568slOTAduSSXFzQNKRKX5MgG4pKAXXddyArSRWwi6ff9",
+	"s3Za4aN2OFkgOuw6BVjr/DHSsDDGGH0jW+UPfBLsVKiGETMU1PABNbsjUxMqyNtLUb+K+DHSAPiJYtcm",
+	"UC5R7RIkWerPyYRCwQOmAQ7GGvLfVVnCntfYurHzhgeZ8Ew9wV850isfod8B3H70tHD5U7qx3b02gL3A",
+	"2xaUYtiNmuS65Xw/Nl+HiiwyeXEvIUPCouBd/Ec+K3TDX/QLaAxaQarGhMJwoFX6KtNpLeNgi+2N0uar",
+	"sTSXdO8SpiqfgEioAkM8CxmQvXRsXJI4wWGGgwss5IuOiiUkP5F7CeAYbhXHZ7i8oKqXZcW7e50vEw2U",
+	"gfej5L7dp9NaxGTCto+AjbUuxx5zhvn1ZY15PKIeBal98V/YHXr8idwrF7SG69G00eUmo/1yZ0TfrbHD",
+	"bM8TAyyzQ8u8Vu72Run0yY+4995PvceFOePITvGf4RmWEnN/rvLTI+ubRWzYgG/aKo9g/RZA2foYbEBp",
+	"e6MUiLmjygsO6AEVB9iwSxMuIilElWHFLgbVCBo34B/x5vqodedHMMVmXlS/n8NK9gLWvxZNvVxbeVC5",
+	"/S2rPTvkka23OhF9lYXZ2vKGM3HA9uQMdvxxwYn77LdY4t6lWjNOuHoAZcAivTG5BQ7t8dpvv2MTYy4Q",
+	"plgm2rSH1g3CMJ2tUVDEIwrqQQqSulEz+GQU9ugMoyOVYtlAjqLAnmq5ulzemoe1ri3qRK2mi7FcWx6s",
+	"rfwIZtrUuKnfpl4F/Hfl5je15UE/zzUIL63Psx43yEShHuBJPj91AqycG5Og7k+VwEjU72BTBoL0Kguz",
+	"1Sc/Vb9fJualafyGqTFlFutMhe0bALC4UyeY4X0YuxrUnWDAYKifO86POZP+fAzCGtO1+WVTn+HOnj5V",
+	"Jy7ZCaubzwYr1ybNQcOaNKpDS9ZQiQC5NLLQTdxmAnTj850fuKCxANTSozxJ45BYwvo0OZcaA9pRh1cz",
+	"0Iq3reghteoAtkFgkQFkudsCeVUH6W8CkFpdmvaoVa7Nuc/H+mnUK6oaUj6w1fHWKikN3dkHNNVW6ZTu",
+	"uTUHJu2nNuoaZ2ckUd5S3fQ0uoQUrSGqHgE8B4BkB4gcc3x+DggdiSubumEao7HQZdZ02JGNxNf7eQ9O",
+	"H4kVVZVo3lfcOFbSEyhpjPmeaYUrOEiOThyK6QViGD5Q8HJWbgHSvL1R6u/v70/mcslMhuvrS+dy/oOz",
+	"PdX+bjLVlkwd41LH0xh9pNAgn+b/7/z5zNWOgST8127/x5H/0uS/v7BYGAaJtcyP+gSpFzVAcJ3gI59P",
+	"wHW6emAqljHPhhVICIM1MkEsbrDnsT8EAPjiemV2pbb8EJsZa5xUyGYJXeAvCF6yw0e0YAxNaE+vxp2e",
+	"dtY6i/+Bkn8TVU1W+psOUyJC4JKosgAd2vanSOlFDlrUQsy1WaY0Bw0gDyij5GGINRgZ3ZpZcDwYkYvz",
+	"prnwfELBDqrZG+nZKtAzEunzsFWQafCOby6EwH7nQn94JBIGA4qLmJhP4V+9zBXETHhzalP7xC2tGFuF",
+	"5rT6DogRCBFq4+iBMPhz9cYKW5UN29lfhli11Bno45/tjdLHfz3DYev5iH1ucTgC+Kb16pZzFnHkN9C1",
+	"MSrxbxz/M+aX/E7aQ5gEd/F60LFJrd/VzVc/WA9v4/UhTkq/v9oN3DQz/C5qC/rnYA4a56VQFyjIW1mD",
+	"U13RUIaIYjJLeLW4LkpdeUXuVZCqwv6zs0nIjxlZQmBY4xQQ8pUsdfXJWWiIZPnYAwggHxBu7SL85vrP",
+	"WGUaNfXVyvg3eLB4foP65rP5ys3n9pczLrVqjUIJfjhjujL+DTwJFvuyOWh4ltE1Xe/ZfU448q9O+Cd1",
+	"5HhX59VUov34wF8ar/UpqUeO9ID7NsFvxuaL4ero08rQGF4gSj437rZzn3lldtB6NInXmUS1j7O95WEW",
+	"5D6fCI33+Y5ijX2LE3KM+9XkcN9LfDpQU6rB8YYRJCdPjPMmyzmpYWExo5rczHjCSPsPWbnYk5UvB2dc",
+	"T6pSd0BtFy1jBacGg5sPo5nedlXIt6x7EodEOjhoHCZqZC3wczfpxT6b71WEDPpzAVSdUYR4S0EpGHwj",
+	"S/MgODzCzgGRhroLiqj1fwlCjwz0AhIUpNT/OmmP9+//OMMHcuD/cYbDODv3QUHrQ5JGHaXbG6WTooIu",
+	"CCriTp1wRxdTjw3Eom2YxkuzOAqiMCSTa3ujlBe0rqNHj8JrhuFRM40xoidigY0PXjJyhwp9mpYnyYYi",
+	"VfoY+WOrprEIDp9iqTqzvjX+S+X+HM58GMfZhtdMfe7M5yc+x7owHpoxDSmJuO/z0nkpzIGMKwKAhAad",
+	"GztZzeI68Y6e6zxKvdccKNRfQdh2XjvyiSD1FoRe9BUHnqfXs9jhtgh4wuyD2sqP2xulrwWcovEKB8St",
+	"1cYek6+R5ARM4t+XcGT2DNHhgYz3TWMB5opnCYdNadj6ZYqkvYcZOdxXcEZ+ZRbXv3JGDaFuX3GYAq8h",
+	"TNOlq9PDiPf4p/gEfwkpxIbm246mjqZoWpEEP6b5Y/grEhOCWS8pQNaZ13qC7/OyysiEqI49qH2zYupl",
+	"rCkHDIra65fWKM1vMgcN3DQHTkB6+NqqGx6/E+14KsOn+S9kVcMJcHUdAKm0RhFStQ/lTH+DhF07UTde",
+	"UmxYkO6AV5ICHOQvctSeamvZMPw6OKOCgpfChLwkuQyWtSOVCuvDGXTSVZYJv9IW/Yo/e7ojdSz6JU/V",
+	"nHfijIxVWsctIDEMb4vGc51Qhkkt5HKC0m9jGSzygO+vPF+dGq4NDuFCMQMJm8lxqACMqxcxeNsaWvYh",
+	"QZvPBmuLS4AYUihhB3z9MdKcvE6VT3gqs4V4GeqPJElxtoFE5IO0thrQyMevqZbxKzu7lVlmqk5GQkNC",
+	"wEPO7W9AnSjGTV6lAVwDSZoLHS6nPYxsTFevzVujz63xm3aBpRWzeAOHfRRN43ezeM+Tym1MWwuPrdHn",
+	"EK6h39mFKMc8D/+cypygI252A9Ap7xNjRzGzQ8e3gZM7Uh3Rbzg1tPad9R1aNsH3SGrM9i4+tzOLvRuB",
+	"BIngB2Zaw9h/lf4AfO2Q5ZCvd83XDi2b4GsSuBimmGyur0O8ml52d0N18JarKISrz9BIyjeQqVnJKMwq",
+	"SzZx3h7d4w3nbA/LNdBZshCJ30g3wUGhxgLRNXAxq+EwcYuj+vfIDAykP+yz/RfMWGCK6Tq1dsfD7cej",
+	"X/FXZNs1j7kYqT4Ph03kghbNJz9htKgUh1WgvT3jFV9uxv4ziz9BowG3UJodGlz9QaIQ7lNoVGY8G4rg",
+	"lJEsaId67hETssJr95kNmcGsUVYTAXnfauHln42XiZKeVMswdpqnuhjAwiMEu8ZZByRGZbR2f9HUb9Fc",
+	"pfHX1tSEz3ixCy9QFQ9HBkKIEBfQJwvEuUOi3h5Q5vUi+OHM68Sp8/vAR+ygeAZDBSny9rNV2JwIcwUg",
+	"eaZxQEJWVu24UHbcMMmHIeEv4Onw2gosgyCAwu+RNh8SxhKNhbN1+xYtDANcdvdnr49d6ZK53x3hYBbX",
+	"iUpMkwad2prW5E1T/9nU5ziP39Tx9lwDH9PNR1hAXAstvGhMV2de4GOJLqe/McPVBol2Wq2+LJv6RGXy",
+	"Dom/D5MIpE7kXp1lwRymWGdZao+G0ED0DE8QEh+AOnXA514I0zkUITvBd/yEyiq3pKvd/7X65FEQuGAJ",
+	"Ixcw8cZiEozyDRFqEaHAITrRInTCTU5ai6G7rzEPVopD1t3HpjHN4RINOJSE3C5g56IfO/5uwpOMnoNn",
+	"juC27YR0JzrgvFS/noA28O7xVLu3AXjJ//7SVnHZKg1X7jzBotrW73ANRgz7jZjGQ+ylmbcDCtY4HPTs",
+	"23n4UgQ7lAWyiUnOOg5LCH4NoRAkF2J7o4SzJKCsBJS6IKNyarXj94MnBEyidVsz7hHjIl78DVovXgK8",
+	"1WhB47fpiz7a58OLEUYXBfJjbq/+vrx1Z/gPKW86UjEOS/81KvBe2ztx5sOoIL//zgW8hm5xgUVdQYsp",
+	"6Mjqhx+2XxS0/d/RO2P6A1EZD3fdQXvXXORkKp8RvrRGPrMI1fPQJ3bIj0o/g4xYBDMxAIIj+IE7O89s",
+	"e6PkQmXGSVLS9sZIjNqrnvSuRrU76QU8ZTszYpmWdSyu2cbcDeok1pcqC7OVuXVXgWFvnXK2Eiirrd4j",
+	"exnveUCoOaPsbNj2JMnkf1r1rMFlXxgOaY/bhO/at/0TDHgBGxxNyau00vYAkRf4Xo0wyeFOVWedUCfw",
+	"6/4NCP/sRnuLDjelU2Cdah3syZCZHJ44O2YsQkDoL1Sx2SGmdvA803JNKBqJq9PrUBfaJWfGRN6oSHsj",
+	"sbbz0g7QNr8855wEJFx3FnMzl7Sz95OcO/cWV8cb1M9L3i+NaVrXpeS56RRXAXBBc7T8uqkv06Im+rh9",
+	"UlDljWYiNcqOrVcWcHKr6xk8oCRWns3bOU2R4N+ByJAdQoZOkYNzV3k5z6frBX9J/V7eU5wX97UXKKPr",
+	"UkFPf2nggNgX/Pmq7ewzHtKUuHWDIBA4iD+S+kbwM73V+Y+n8ca8ZLLVt++G3ElVW35sTa7ZAfL1Av/1",
+	"a3jZ18ZiE1B3BDAX+ypmklDqvQzaXuvdIK9vgTEQC6il56IPmvUG3o4HDXa77o3/ULpMK1fg7N1pOy7E",
+	"vsvBgQ0G9WCNG3I3RaBkwqr16jscqLBIb7Gj50ijeKKC9rYcDs2Cv03DCKk9GUB8UXsII7yFMEIkwl1X",
+	"O5O99AYttRGggOX8NVN/uEtU4WOnszcGXnCmdogx7MbL4qViOKjt5iQfsh0LHj4wPtorYNl/td6BgMuB",
+	"a/RYKqG9cn9glHkfN0oTUG+yjxRbjQjjLZPaj3RXFddtnZAWrufauM31p0SxsybhbghmPK81da06UiK1",
+	"Rtx1Lu0LIcr1GrbFdV+VWXzxwCpo+cZ4yJ4OBQ9pQdm3HkNkFccN0bMo9R7fqzx8EsQR/9RwdYAyTW2U",
+	"5FUFXRpIKrjseHiQtV2cCNgb83zZGh6yyuAqtX2vz0Yrd57hULYZ4tR0wqHxC9Qh6rmgwhiz77iILFm+",
+	"xvKkBk2o8S39Ps7suBG+r8LPSocZL5Ey7Puyw6Ifheq1e3WyBuvND9Bj9aCRLOvVfWuo6KBY5OOfAMX6",
+	"gxtcpjG9pX9rfbtOTmGor1taN/WZBlIrfcEpOxce/aGX/ad5cZ3aX8Y0RtOW8X03i7Qs7cIS15ZK0XMe",
+	"ikAvYJ9ECVc8+xXwIAjI/90sLsFHfckqz7mTP2orE3DLU3G9tjKBYb41ro3D10rdNfXr8KQx6Yig85Kg",
+	"yTmxG18CBWqqc/0vuZfeEXJE8QDExzBsaI86IKyh5c31p5DKYoxWnpaIhMNX7uRyoqahzP/0CFkVEfTQ",
+	"BQuuQGgKzn6xQcNyvc7xGtfR3sFhDWbFHipuxnMRvRtktBuBWYKc9o4HImTKY7hs8po1OVG5fdfrKKn+",
+	"NlX5cRYu0KXNLJOSyVi9Aj+NNXWNMUysYO32JnsY7dA9eySxToUPMd+9aVE2eFQHiI65+g+X3nD309gD",
+	"Zy3Jwh/WUsvIbsKEybyLSFIbJPfEKi/ZoKxa8EJ8XLvctkbsVIRIgwQP880syIPrqeIRRqc7sul3WE8t",
+	"YI/HoFQDNAtE8RquiHYL3jaufXTKmrqOj5FyXJ4OAGFMViaFzQNFS5eIVwVXEG3GPGgBm+8VIubi8wMF",
+	"xTzj2MFee3tgsgPJE93V7mwIngFvAyhwEUlRkZLx92iwqmEczwcMBf+7185LMte4Po+wzFw8x0Nkt6W8",
+	"SojK5lVaaiPcCmSU8tBXmcVAwBTE99xSTAkC0aarT6ZwVezrEJBVr+VBz5mCmMH5nKDBcY4HH5uVr6yN",
+	"G6Y+UX06A+EDcY8VWhb+Dcz8CtTt33fne7BgPuMscdbocA/uaA8Gt4tDUfYGvOy6qSXctRK4P8oP0TKs",
+	"kTp6W73/wg081Asy+ptdjREb4zVXnItm3kiDxXMVDjPT0Y9+H5AXZD+yENlTjYjriua7ejEWvURYBrNb",
+	"PcbXKj0lMb64WtSkg4lFRF21iLNaL8uDTLV/Urx5hiYRdIemdn8D6hBKqrhJwmIFJUtvA0knk1m5W8j2",
+	"yaqWfj/1fope3A/3zv7/AJbNpnO3rAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file